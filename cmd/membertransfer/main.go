@@ -0,0 +1,171 @@
+// 회원 일괄 가져오기 / 내보내기 CLI
+//
+// 설정은 서버와 같은 방식으로 로드하므로 cmd/membertransfer 디렉터리에서 실행
+//
+//	go run . import -format csv -dry-run -errors errors.jsonl members.csv
+//	go run . export -format jsonl -columns email,name,roles -status ACTIVE -o members.jsonl
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"study/internal/config"
+	"study/internal/database"
+	"study/internal/feature/transfer"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/pkg/log"
+	"study/pkg/util"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	// .env 파일 로드
+	if err := godotenv.Load(util.GetPath(".env")); err != nil {
+		log.Error(".env 파일을 찾을 수 없습니다.", log.MapErr("error", err))
+	}
+
+	// config 로드
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error("설정 파일을 불러오는데 실패했습니다", log.MapErr("error", err))
+		os.Exit(1)
+	}
+
+	// db 연결 (마이그레이션은 서버 기동 시 적용)
+	postgresdb, err := database.NewPostgres(&cfg.Postgres)
+	if err != nil {
+		log.Error("PostgresDB 연결에 실패했습니다", log.MapErr("error", err))
+		os.Exit(1)
+	}
+	defer postgresdb.Close()
+
+	// 결과 파일 업로드는 관리자 API 작업에서만 사용 (저장소 없음)
+	service := transfer.NewTransferService(postgresdb, query.New(postgresdb), nil, &cfg.MemberTransfer, &cfg.Storage)
+
+	switch os.Args[1] {
+	case "import":
+		err = runImport(service, os.Args[2:])
+	case "export":
+		err = runExport(service, os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Error("회원 일괄 작업 실패", log.MapStr("command", os.Args[1]), log.MapErr("error", err))
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: membertransfer import [-format csv|jsonl] [-dry-run] [-grant-admin] [-errors file] <file>")
+	fmt.Fprintln(os.Stderr, "       membertransfer export -o file [-format csv|jsonl] [-columns a,b] [-status ACTIVE,DISABLED]")
+}
+
+// 가져오기 (보고서는 JSON 으로 표준 출력, 전체 행 오류는 -errors 파일)
+func runImport(service *transfer.TransferService, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	formatFlag := fs.String("format", "", "csv | jsonl (기본: 파일 확장자)")
+	dryRun := fs.Bool("dry-run", false, "검증만 하고 저장하지 않음")
+	grantAdmin := fs.Bool("grant-admin", false, "roles 컬럼의 ADMIN 부여 허용")
+	errorsPath := fs.String("errors", "", "행 오류 JSONL 파일")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		usage()
+		os.Exit(2)
+	}
+	path := fs.Arg(0)
+
+	format, err := transfer.ParseFormat(*formatFlag, path)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// 감사 로그는 actor 없이 CLI 경로로 기록
+	opts := transfer.ImportOptions{Format: format, DryRun: *dryRun, GrantAdmin: *grantAdmin, Source: "cli"}
+	if *errorsPath != "" {
+		errFile, err := os.Create(*errorsPath)
+		if err != nil {
+			return err
+		}
+		defer errFile.Close()
+		opts.Errors = errFile
+	}
+
+	report, err := service.Import(context.Background(), src, opts)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// 내보내기 (로그가 표준 출력을 쓰므로 -o 필수)
+func runExport(service *transfer.TransferService, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	formatFlag := fs.String("format", "", "csv | jsonl (기본: 출력 파일 확장자)")
+	columns := fs.String("columns", "", "내보낼 컬럼 (쉼표 구분, 기본: 전체)")
+	statuses := fs.String("status", "", "회원 상태 (쉼표 구분, 기본: 삭제되지 않은 전체)")
+	outPath := fs.String("o", "", "출력 파일")
+	fs.Parse(args)
+
+	if *outPath == "" || fs.NArg() != 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	format, err := transfer.ParseFormat(*formatFlag, *outPath)
+	if err != nil {
+		return err
+	}
+
+	opts := transfer.ExportOptions{Format: format, Columns: splitList(*columns)}
+	for _, status := range splitList(*statuses) {
+		opts.Statuses = append(opts.Statuses, model.Status(strings.ToUpper(status)))
+	}
+
+	out, err := os.Create(*outPath)
+	if err != nil {
+		return err
+	}
+
+	count, err := service.Export(context.Background(), out, opts)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(*outPath)
+		return err
+	}
+
+	log.Info("회원 내보내기 완료", log.MapStr("file", *outPath), log.MapInt("count", count))
+	return nil
+}
+
+func splitList(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
+}
@@ -5,11 +5,14 @@ import (
 	"study/internal/config"
 	"study/internal/database"
 	"study/internal/feature/auth"
+	"study/internal/mail"
 	"study/internal/metrics"
 	"study/internal/middleware"
 	"study/internal/observability"
 	"study/internal/query"
 	"study/internal/router"
+	"study/internal/sms"
+	"study/internal/storage"
 	"study/pkg/log"
 	"study/pkg/response"
 	"study/pkg/util"
@@ -29,6 +32,7 @@ func main() {
 	cfg, err := config.Load()
 	if err != nil {
 		log.Error("설정 파일을 불러오는데 실패했습니다", log.MapErr("error", err))
+		return
 	}
 
 	// OpenTelemetry 초기화
@@ -40,7 +44,13 @@ func main() {
 	defer shutdown(context.Background())
 
 	// fiber app 생성
-	app := fiber.New()
+	// - 본문은 스트리밍으로 받고 크기 제한은 router 의 BodyLimit 미들웨어에서 (회원 가져오기만 큰 파일 허용)
+	// - multipart 미리 파싱을 끄지 않으면 크기와 관계없이 전체 본문을 임시 파일로 읽음
+	app := fiber.New(fiber.Config{
+		BodyLimit:                    cfg.Storage.BodyLimit(),
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+	})
 
 	// metrics 초기화
 	metrics.Init()
@@ -68,12 +78,48 @@ func main() {
 	queries := query.New(postgresdb)
 
 	// auth 관련
-	jwtService := auth.NewJwtService(&cfg.JWT)
-	cookieService := auth.NewCookieService(&cfg.Cookie)
-	authMiddleware := middleware.NewAuthMiddlewareConfig(cfg.Cookie.Name)
+	opaqueStore := auth.NewOpaqueTokenStore(queries)
+	jwtService := auth.NewJwtService(&cfg.JWT, auth.WithOpaqueStore(opaqueStore))
+	if cfg.JWT.AccessTokenMode == auth.AccessTokenModeOpaque {
+		// 만료된 불투명 access 토큰 주기 삭제
+		go opaqueStore.RunPurge(context.Background())
+	}
+	cookieService, err := auth.NewCookieService(&cfg.Cookie)
+	if err != nil {
+		log.Error("쿠키 설정 초기화에 실패했습니다", log.MapErr("error", err))
+		return
+	}
+	dpopVerifier := auth.NewDPoPVerifier(&cfg.DPoP)
+	idTokenSigner, err := auth.NewIDTokenSigner(&cfg.OIDC)
+	if err != nil {
+		log.Error("OIDC 서명 키를 불러오는데 실패했습니다", log.MapErr("error", err))
+		return
+	}
+	authMiddleware := middleware.NewAuthMiddlewareConfig(cookieService, dpopVerifier)
+
+	// 오브젝트 저장소 (프로필 이미지)
+	store, err := storage.New(&cfg.Storage)
+	if err != nil {
+		log.Error("오브젝트 저장소 초기화에 실패했습니다", log.MapErr("error", err))
+		return
+	}
+
+	// SMS 발송 (전화번호 인증)
+	smsSender, err := sms.New(&cfg.SMS)
+	if err != nil {
+		log.Error("SMS 발송기 초기화에 실패했습니다", log.MapErr("error", err))
+		return
+	}
+
+	// 메일 발송 (가입 승인 안내)
+	mailSender, err := mail.New(&cfg.Mail)
+	if err != nil {
+		log.Error("메일 발송기 초기화에 실패했습니다", log.MapErr("error", err))
+		return
+	}
 
 	// 라우터
-	router.Register(app, postgresdb, queries, jwtService, cookieService, authMiddleware)
+	router.Register(app, cfg, postgresdb, queries, store, smsSender, mailSender, jwtService, cookieService, dpopVerifier, idTokenSigner, authMiddleware)
 
 	// metrics 등록
 	metrics.Register(app)
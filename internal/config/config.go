@@ -1,8 +1,11 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/mail"
 	"os"
+	"strings"
 	"study/pkg/log"
 	"study/pkg/util"
 
@@ -11,13 +14,25 @@ import (
 )
 
 type Config struct {
-	App           App
-	Postgres      Postgres `yaml:"postgres"`
-	JWT           JWT
-	Log           Log           `yaml:"log"`
-	Cors          Cors          `yaml:"cors"`
-	Cookie        Cookie        `yaml:"cookie"`
-	Observability Observability `yaml:"observability"`
+	App               App
+	Postgres          Postgres `yaml:"postgres"`
+	JWT               JWT
+	Log               Log               `yaml:"log"`
+	Cors              Cors              `yaml:"cors"`
+	Cookie            Cookie            `yaml:"cookie"`
+	Password          Password          `yaml:"password"`
+	DPoP              DPoP              `yaml:"dpop"`
+	Introspection     Introspection     `yaml:"introspection"`
+	OIDC              OIDC              `yaml:"oidc"`
+	Scim              Scim              `yaml:"scim"`
+	Authenticator     Authenticator     `yaml:"authenticator"`
+	Storage           Storage           `yaml:"storage"`
+	SMS               SMS               `yaml:"sms"`
+	Mail              Mail              `yaml:"mail"`
+	Signup            Signup            `yaml:"signup"`
+	PhoneVerification PhoneVerification `yaml:"phoneVerification"`
+	MemberTransfer    MemberTransfer    `yaml:"memberTransfer"`
+	Observability     Observability     `yaml:"observability"`
 }
 
 func Load() (*Config, error) {
@@ -42,10 +57,347 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	// 설정값 검증
+	if err := cfg.Cookie.Validate(); err != nil {
+		fmt.Println("cookie 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.JWT.Validate(); err != nil {
+		fmt.Println("jwt 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.Introspection.Validate(); err != nil {
+		fmt.Println("introspection 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.Scim.Validate(); err != nil {
+		fmt.Println("scim 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.Authenticator.Validate(); err != nil {
+		fmt.Println("authenticator 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.Storage.Validate(); err != nil {
+		fmt.Println("storage 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.SMS.Validate(); err != nil {
+		fmt.Println("sms 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.Mail.Validate(); err != nil {
+		fmt.Println("mail 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.Signup.Validate(); err != nil {
+		fmt.Println("signup 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.PhoneVerification.Validate(); err != nil {
+		fmt.Println("phoneVerification 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
+	if err := cfg.MemberTransfer.Validate(); err != nil {
+		fmt.Println("memberTransfer 설정이 올바르지 않습니다 :", err)
+		return nil, err
+	}
+
 	// 로깅 초기화
 	log.Info("실행환경", log.MapStr("env", cfg.App.Env), log.MapStr("logLevel", cfg.Log.Level))
 	log.Init(cfg.App.Env, cfg.Log.Level)
-	log.Info("JWT", log.MapInt("accessExpireMin", cfg.JWT.AccessExpireMin), log.MapInt("refreshExpireDay", cfg.JWT.RefreshExpireDay))
+	log.Info("JWT", log.MapInt("accessExpireMin", cfg.JWT.AccessExpireMin), log.MapInt("refreshExpireDay", cfg.JWT.RefreshExpireDay), log.MapInt("refreshIdleMin", cfg.JWT.RefreshIdleMin), log.MapStr("accessTokenMode", cfg.JWT.AccessTokenMode), log.MapStr("encryption", cfg.JWT.Encryption))
 
 	return &cfg, nil
 }
+
+// JWT 설정 검증 (암호화 모드는 별도 시크릿 필요)
+func (j *JWT) Validate() error {
+	// 오타가 JWT 모드로 처리되면 폐기 가능하다고 믿은 토큰이 만료까지 유효하므로 기동 차단
+	// (auth.AccessTokenModeJWT / AccessTokenModeOpaque)
+	switch j.AccessTokenMode {
+	case "jwt", "opaque":
+	default:
+		return fmt.Errorf("accessTokenMode 는 jwt, opaque 중 하나: %q", j.AccessTokenMode)
+	}
+
+	switch j.Encryption {
+	case "", "none":
+	case "dir", "A256KW":
+		// 키는 시크릿의 SHA-256 이므로 시크릿 자체가 키 길이(32바이트) 이상이어야 함
+		if len(j.EncryptionSecret) < 32 {
+			return errors.New("encryption 사용 시 JWT_ENCRYPTION_SECRET 32바이트 이상 필요")
+		}
+	default:
+		return fmt.Errorf("encryption 은 none, dir, A256KW 중 하나: %q", j.Encryption)
+	}
+
+	return nil
+}
+
+// 쿠키 설정 검증 (브라우저가 거부하는 조합은 기동 시점에 차단)
+func (c *Cookie) Validate() error {
+	switch strings.ToLower(c.SameSite) {
+	case "lax", "strict":
+	case "none":
+		if !c.Secure {
+			return errors.New("sameSite None 은 secure 필요")
+		}
+	default:
+		return fmt.Errorf("sameSite 는 Lax, Strict, None 중 하나: %q", c.SameSite)
+	}
+
+	// CHIPS 파티션 쿠키는 Secure 필수
+	if c.Partitioned && !c.Secure {
+		return errors.New("partitioned 는 secure 필요")
+	}
+
+	switch c.Prefix {
+	case "", "none":
+	case "host":
+		// __Host- : Secure, Path=/, Domain 없음
+		if !c.Secure || c.Path != "/" || c.Domain != "" {
+			return errors.New("prefix host 는 secure, path \"/\", domain 미설정 필요")
+		}
+	case "secure":
+		// __Secure- : Secure
+		if !c.Secure {
+			return errors.New("prefix secure 는 secure 필요")
+		}
+	default:
+		return fmt.Errorf("prefix 는 none, host, secure 중 하나: %q", c.Prefix)
+	}
+
+	switch c.Seal {
+	case "", "none":
+	case "sign", "encrypt":
+		if len(c.Secret) < 32 {
+			return errors.New("seal 사용 시 COOKIE_SECRET 32바이트 이상 필요")
+		}
+	default:
+		return fmt.Errorf("seal 은 none, sign, encrypt 중 하나: %q", c.Seal)
+	}
+
+	switch c.Mode {
+	case "", "token", "bff":
+	default:
+		return fmt.Errorf("mode 는 token, bff 중 하나: %q", c.Mode)
+	}
+
+	return nil
+}
+
+// 인트로스펙션 설정 검증 (등록 클라이언트마다 env 시크릿 필요)
+func (i *Introspection) Validate() error {
+	for _, client := range i.Clients {
+		if err := validateSecret("INTROSPECTION_CLIENT_SECRETS", client.ID, i.Secrets[client.ID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SCIM 설정 검증 (등록 테넌트마다 env 토큰 필요)
+func (s *Scim) Validate() error {
+	for _, tenant := range s.Tenants {
+		if err := validateSecret("SCIM_TENANT_TOKENS", tenant.ID, s.Tokens[tenant.ID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 호출자 인증 시크릿 검증 (미설정 / 예시값은 기동 차단)
+func validateSecret(env string, id string, secret string) error {
+	if id == "" {
+		return errors.New("id 는 필수")
+	}
+	if strings.TrimSpace(secret) == "" {
+		return fmt.Errorf("%s 에 %q 값 필요", env, id)
+	}
+	if strings.Contains(strings.ToUpper(secret), "CHANGE_ME") {
+		return fmt.Errorf("%s 의 %q 값이 예시값", env, id)
+	}
+
+	return nil
+}
+
+// 인증 체인 설정 검증
+func (a *Authenticator) Validate() error {
+	for _, name := range a.Chain {
+		switch name {
+		case "local":
+		case "ldap":
+			if err := a.LDAP.Validate(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("chain 은 local, ldap 중 하나: %q", name)
+		}
+	}
+
+	return nil
+}
+
+// LDAP 설정 검증
+func (l *LDAP) Validate() error {
+	if !strings.HasPrefix(l.URL, "ldap://") && !strings.HasPrefix(l.URL, "ldaps://") {
+		return fmt.Errorf("ldap url 은 ldap:// 또는 ldaps:// 로 시작: %q", l.URL)
+	}
+	if l.BaseDN == "" {
+		return errors.New("ldap baseDn 필요")
+	}
+	if strings.Count(l.UserFilter, "%s") != 1 {
+		return fmt.Errorf("ldap userFilter 는 %%s 를 한 번 포함: %q", l.UserFilter)
+	}
+	if l.EmailAttr == "" {
+		return errors.New("ldap emailAttr 필요")
+	}
+
+	return nil
+}
+
+// 오브젝트 저장소 설정 검증
+func (s *Storage) Validate() error {
+	if s.URLExpireMin <= 0 {
+		return errors.New("storage urlExpireMin 은 1 이상")
+	}
+	if s.MaxUploadMB <= 0 {
+		return errors.New("storage maxUploadMB 는 1 이상")
+	}
+
+	switch s.Backend {
+	case "local":
+		if s.Local.Dir == "" || s.Local.BaseURL == "" {
+			return errors.New("storage local 은 dir, baseUrl 필요")
+		}
+		if len(s.SigningSecret) == 0 {
+			return errors.New("storage local 은 STORAGE_SIGNING_SECRET 필요")
+		}
+	case "s3":
+		if s.S3.Endpoint == "" || s.S3.Region == "" || s.S3.Bucket == "" {
+			return errors.New("storage s3 는 endpoint, region, bucket 필요")
+		}
+		if s.S3.AccessKey == "" || s.S3.SecretKey == "" {
+			return errors.New("storage s3 는 S3_ACCESS_KEY, S3_SECRET_KEY 필요")
+		}
+	default:
+		return fmt.Errorf("storage backend 는 local, s3 중 하나: %q", s.Backend)
+	}
+
+	return nil
+}
+
+// 업로드 허용 요청 본문 크기 (전체 경로 기본 제한, multipart 헤더 여유 포함)
+func (s *Storage) BodyLimit() int {
+	return (s.MaxUploadMB + 1) * 1024 * 1024
+}
+
+// 회원 가져오기 / 내보내기 설정 검증
+func (m *MemberTransfer) Validate() error {
+	if m.BatchSize <= 0 || m.MaxUploadMB <= 0 || m.JobTimeoutMin <= 0 || m.ResultTTLHours <= 0 {
+		return errors.New("memberTransfer batchSize, maxUploadMB, jobTimeoutMin, resultTTLHours 는 1 이상")
+	}
+	if m.MaxReportErrors < 0 {
+		return errors.New("memberTransfer maxReportErrors 는 0 이상")
+	}
+
+	return nil
+}
+
+// 가져오기 파일 업로드 허용 요청 본문 크기 (가져오기 경로만 적용, multipart 헤더 여유 포함)
+func (m *MemberTransfer) BodyLimit() int {
+	return (m.MaxUploadMB + 1) * 1024 * 1024
+}
+
+// SMS 발송 설정 검증
+func (s *SMS) Validate() error {
+	switch s.Provider {
+	case "console":
+	case "file":
+		if s.File.Path == "" {
+			return errors.New("sms file 은 path 필요")
+		}
+	case "http":
+		if s.HTTP.URL == "" || s.HTTP.TimeoutSec <= 0 {
+			return errors.New("sms http 는 url, timeoutSec 필요")
+		}
+		if s.HTTP.APIKey == "" {
+			return errors.New("sms http 는 SMS_API_KEY 필요")
+		}
+	default:
+		return fmt.Errorf("sms provider 는 console, file, http 중 하나: %q", s.Provider)
+	}
+
+	if s.Sender == "" {
+		return errors.New("sms sender 필요")
+	}
+
+	return nil
+}
+
+// 메일 발송 설정 검증
+func (m *Mail) Validate() error {
+	switch m.Provider {
+	case "console":
+	case "file":
+		if m.File.Path == "" {
+			return errors.New("mail file 은 path 필요")
+		}
+	case "smtp":
+		if m.SMTP.Host == "" || m.SMTP.Port <= 0 || m.SMTP.TimeoutSec <= 0 {
+			return errors.New("mail smtp 는 host, port, timeoutSec 필요")
+		}
+		if m.SMTP.Username != "" && m.SMTP.Password == "" {
+			return errors.New("mail smtp username 사용 시 MAIL_SMTP_PASSWORD 필요")
+		}
+	default:
+		return fmt.Errorf("mail provider 는 console, file, smtp 중 하나: %q", m.Provider)
+	}
+
+	if _, err := mail.ParseAddress(m.From); err != nil {
+		return fmt.Errorf("mail from 형식 오류: %q", m.From)
+	}
+
+	return nil
+}
+
+// 가입 방식 설정 검증
+func (s *Signup) Validate() error {
+	switch s.Mode {
+	case "", "open", "approval":
+	default:
+		return fmt.Errorf("signup mode 는 open, approval 중 하나: %q", s.Mode)
+	}
+
+	return nil
+}
+
+// 가입 후 관리자 승인 필요 여부
+func (s *Signup) RequiresApproval() bool {
+	return s.Mode == "approval"
+}
+
+// 전화번호 인증 설정 검증
+func (p *PhoneVerification) Validate() error {
+	if p.CodeLength < 4 || p.CodeLength > 10 {
+		return errors.New("phoneVerification codeLength 는 4~10")
+	}
+	if p.ExpireMin <= 0 || p.MaxAttempts <= 0 || p.DailyLimit <= 0 || p.ResendCooldownSec < 0 {
+		return errors.New("phoneVerification expireMin, maxAttempts, dailyLimit 는 1 이상")
+	}
+
+	return nil
+}
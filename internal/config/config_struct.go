@@ -17,10 +17,19 @@ type Postgres struct {
 }
 
 type JWT struct {
-	AccessSecret     []byte `env:"JWT_ACCESS_SECRET" env-required:"true"`
-	RefreshSecret    []byte `env:"JWT_REFRESH_SECRET" env-required:"true"`
-	AccessExpireMin  int    `env:"JWT_ACCESS_EXPIRE_MIN" env-default:"30"`
-	RefreshExpireDay int    `env:"JWT_REFRESH_EXPIRE_DAY" env-default:"14"`
+	AccessSecret     []byte   `env:"JWT_ACCESS_SECRET" env-required:"true"`
+	RefreshSecret    []byte   `env:"JWT_REFRESH_SECRET" env-required:"true"`
+	AccessExpireMin  int      `env:"JWT_ACCESS_EXPIRE_MIN" env-default:"30"`
+	RefreshExpireDay int      `env:"JWT_REFRESH_EXPIRE_DAY" env-default:"14"`
+	RefreshIdleMin   int      `env:"JWT_REFRESH_IDLE_MIN" env-default:"1440"`
+	Issuer           string   `env:"JWT_ISSUER" env-default:"study"`
+	Audience         []string `env:"JWT_AUDIENCE" env-separator:"," env-default:"study-api"`
+	LeewaySec        int      `env:"JWT_LEEWAY_SEC" env-default:"30"`
+	AccessTokenMode  string   `env:"JWT_ACCESS_TOKEN_MODE" env-default:"jwt"` // jwt | opaque
+	ReauthMaxAgeMin  int      `env:"JWT_REAUTH_MAX_AGE_MIN" env-default:"5"`  // 재인증 유효 시간 (민감 작업 허용)
+	RenewExpired     bool     `env:"JWT_RENEW_EXPIRED" env-default:"false"`   // 인증 미들웨어에서 만료 access 토큰 자동 재발급
+	Encryption       string   `env:"JWT_ENCRYPTION" env-default:"none"`       // none | dir | A256KW (access 토큰 JWE 암호화)
+	EncryptionSecret []byte   `env:"JWT_ENCRYPTION_SECRET"`
 }
 
 type Log struct {
@@ -31,16 +40,165 @@ type Cors struct {
 	AllowOrigins     []string `yaml:"allowOrigins"`
 	AllowMethods     []string `yaml:"allowMethods"`
 	AllowHeaders     []string `yaml:"allowHeaders"`
+	ExposeHeaders    []string `yaml:"exposeHeaders"`
 	AllowCredentials bool     `yaml:"allowCredentials"`
 }
 
 type Cookie struct {
-	Name     string `yaml:"name"`
-	Path     string `yaml:"path"`
-	HttpOnly bool   `yaml:"httpOnly"`
-	Secure   bool   `yaml:"secure"`
-	SameSite string `yaml:"sameSite"`
-	MaxAge   int    `yaml:"maxAge"`
+	Name        string `yaml:"name"`
+	Path        string `yaml:"path"`
+	Domain      string `yaml:"domain"`
+	HttpOnly    bool   `yaml:"httpOnly"`
+	Secure      bool   `yaml:"secure"`
+	SameSite    string `yaml:"sameSite"` // Lax | Strict | None
+	Partitioned bool   `yaml:"partitioned"`
+	Prefix      string `yaml:"prefix"` // none | host | secure
+	Seal        string `yaml:"seal"`   // none | sign | encrypt
+	Secret      []byte `yaml:"-" env:"COOKIE_SECRET"`
+	MaxAge      int    `yaml:"maxAge"`
+	Mode        string `yaml:"mode"` // token | bff
+	AccessName  string `yaml:"accessName"`
+	CsrfName    string `yaml:"csrfName"`
+	CsrfHeader  string `yaml:"csrfHeader"`
+}
+
+type Password struct {
+	HistoryCount int      `yaml:"historyCount"`
+	ExpireDays   int      `yaml:"expireDays"`
+	ExpireRoles  []string `yaml:"expireRoles"`
+}
+
+type DPoP struct {
+	Enabled      bool `yaml:"enabled"`
+	AllowBearer  bool `yaml:"allowBearer"`
+	IatWindowSec int  `yaml:"iatWindowSec"`
+}
+
+type Introspection struct {
+	Clients []IntrospectionClient `yaml:"clients"`
+	Secrets map[string]string     `yaml:"-" env:"INTROSPECTION_CLIENT_SECRETS"` // 클라이언트별 시크릿 (id:secret,id:secret)
+}
+
+type IntrospectionClient struct {
+	ID string `yaml:"id"`
+}
+
+type OIDC struct {
+	Issuer           string `yaml:"issuer"`   // 외부에서 접근하는 서버 기준 URL
+	LoginURL         string `yaml:"loginUrl"` // 미로그인 시 이동할 로그인 페이지 (return_to 전달)
+	CodeExpireSec    int    `yaml:"codeExpireSec"`
+	IDTokenExpireMin int    `yaml:"idTokenExpireMin"`
+	SigningKeyFile   string `yaml:"-" env:"OIDC_SIGNING_KEY_FILE"` // ID 토큰 서명 키 (EC P-256 PEM)
+}
+
+type Scim struct {
+	Tenants []ScimTenant      `yaml:"tenants"`
+	Tokens  map[string]string `yaml:"-" env:"SCIM_TENANT_TOKENS"` // 테넌트별 IdP 등록 Bearer 토큰 (id:token,id:token)
+}
+
+type ScimTenant struct {
+	ID         string `yaml:"id"`
+	AllowAdmin bool   `yaml:"allowAdmin"` // ADMIN Group 구성원 변경 허용 (테넌트 토큰으로 전역 관리자 권한 부여 / 회수)
+}
+
+type Authenticator struct {
+	Chain []string `yaml:"chain"` // local | ldap (순서대로 시도)
+	LDAP  LDAP     `yaml:"ldap"`
+}
+
+type LDAP struct {
+	URL          string            `yaml:"url"` // ldap:// | ldaps://
+	StartTLS     bool              `yaml:"startTls"`
+	TimeoutSec   int               `yaml:"timeoutSec"`
+	BindDN       string            `yaml:"bindDn"` // 사용자 검색용 서비스 계정
+	BindPassword string            `yaml:"-" env:"LDAP_BIND_PASSWORD"`
+	BaseDN       string            `yaml:"baseDn"`
+	UserFilter   string            `yaml:"userFilter"`  // %s 에 로그인 이메일 (escape 처리)
+	SubjectAttr  string            `yaml:"subjectAttr"` // 회원 연결 식별자 (비어 있으면 DN)
+	EmailAttr    string            `yaml:"emailAttr"`
+	NameAttr     string            `yaml:"nameAttr"`
+	GroupAttr    string            `yaml:"groupAttr"`
+	RoleMapping  map[string]string `yaml:"roleMapping"` // 그룹 DN → 권한
+	Provision    bool              `yaml:"provision"`   // 최초 로그인 시 회원 자동 생성
+}
+
+type Storage struct {
+	Backend       string       `yaml:"backend"`                        // local | s3
+	URLExpireMin  int          `yaml:"urlExpireMin"`                   // 다운로드 서명 URL 유효 시간
+	MaxUploadMB   int          `yaml:"maxUploadMB"`                    // 업로드 최대 크기
+	SigningSecret []byte       `yaml:"-" env:"STORAGE_SIGNING_SECRET"` // local 다운로드 URL 서명 키
+	Local         StorageLocal `yaml:"local"`
+	S3            StorageS3    `yaml:"s3"`
+}
+
+type StorageLocal struct {
+	Dir     string `yaml:"dir"`     // 저장 디렉터리 (상대 경로는 프로젝트 루트 기준)
+	BaseURL string `yaml:"baseUrl"` // 서명 URL 의 외부 접근 주소 (예: http://localhost:8080)
+}
+
+type StorageS3 struct {
+	Endpoint  string `yaml:"endpoint"` // https://s3.ap-northeast-2.amazonaws.com, http://localhost:9000 (MinIO)
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	PathStyle bool   `yaml:"pathStyle"` // endpoint/bucket/key (MinIO 등 S3 호환 저장소)
+	AccessKey string `yaml:"-" env:"S3_ACCESS_KEY"`
+	SecretKey string `yaml:"-" env:"S3_SECRET_KEY"`
+}
+
+type SMS struct {
+	Provider string  `yaml:"provider"` // console | file | http
+	Sender   string  `yaml:"sender"`   // 발신 번호 (사전 등록된 번호)
+	File     SMSFile `yaml:"file"`
+	HTTP     SMSHTTP `yaml:"http"`
+}
+
+type SMSFile struct {
+	Path string `yaml:"path"` // 발송 내역 JSONL (상대 경로는 프로젝트 루트 기준)
+}
+
+type SMSHTTP struct {
+	URL        string `yaml:"url"` // 발송 API (POST JSON)
+	TimeoutSec int    `yaml:"timeoutSec"`
+	APIKey     string `yaml:"-" env:"SMS_API_KEY"`
+}
+
+type PhoneVerification struct {
+	CodeLength        int `yaml:"codeLength"`
+	ExpireMin         int `yaml:"expireMin"`
+	MaxAttempts       int `yaml:"maxAttempts"`       // 코드당 확인 시도 횟수
+	ResendCooldownSec int `yaml:"resendCooldownSec"` // 재발송 대기 시간
+	DailyLimit        int `yaml:"dailyLimit"`        // 회원별 24시간 발송 횟수
+}
+
+type Mail struct {
+	Provider string   `yaml:"provider"` // console | file | smtp
+	From     string   `yaml:"from"`     // 발신 주소 (예: "Study <no-reply@example.com>")
+	File     MailFile `yaml:"file"`
+	SMTP     MailSMTP `yaml:"smtp"`
+}
+
+type MailFile struct {
+	Path string `yaml:"path"` // 발송 내역 JSONL (상대 경로는 프로젝트 루트 기준)
+}
+
+type MailSMTP struct {
+	Host       string `yaml:"host"`
+	Port       int    `yaml:"port"` // 587 (STARTTLS) | 465 (TLS)
+	Username   string `yaml:"username"`
+	Password   string `yaml:"-" env:"MAIL_SMTP_PASSWORD"`
+	TimeoutSec int    `yaml:"timeoutSec"`
+}
+
+type Signup struct {
+	Mode string `yaml:"mode"` // open (즉시 사용) | approval (관리자 승인 후 로그인)
+}
+
+type MemberTransfer struct {
+	BatchSize       int `yaml:"batchSize"`       // 가져오기 COPY 단위 (행 수)
+	MaxUploadMB     int `yaml:"maxUploadMB"`     // 관리자 API 가져오기 파일 최대 크기
+	MaxReportErrors int `yaml:"maxReportErrors"` // 결과 보고서에 담는 행 오류 수 (전체는 오류 파일)
+	JobTimeoutMin   int `yaml:"jobTimeoutMin"`   // 비동기 작업 제한 시간
+	ResultTTLHours  int `yaml:"resultTTLHours"`  // 결과 파일 (내보내기, 오류 JSONL) 보관 시간
 }
 
 type Observability struct {
@@ -0,0 +1,25 @@
+package config
+
+import "testing"
+
+func TestJWTValidateAccessTokenMode(t *testing.T) {
+	cases := []struct {
+		mode  string
+		valid bool
+	}{
+		{"jwt", true},
+		{"opaque", true},
+		{"opaq", false},
+		{"JWT", false},
+		{"", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.mode, func(t *testing.T) {
+			cfg := JWT{AccessTokenMode: tc.mode, Encryption: "none"}
+			if err := cfg.Validate(); (err == nil) != tc.valid {
+				t.Errorf("결과 불일치: %v, 허용 기대: %v", err, tc.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"strconv"
+
+	"study/internal/feature/auth"
+	"study/internal/feature/lifecycle"
+	"study/internal/shared/errorx"
+	"study/internal/shared/model"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Handler
+type AdminHandler struct {
+	service *AdminService
+}
+
+func NewAdminHandler(service *AdminService) *AdminHandler {
+	return &AdminHandler{service: service}
+}
+
+// 회원 목록 조회
+func (h *AdminHandler) ListMembers(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req MemberListQuery
+
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(ErrInvalidQuery.Error(), "조회 조건 파싱 실패", nil))
+	}
+
+	members, err := h.service.ListMembers(ctx, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 목록 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("회원 목록 조회 성공", members))
+}
+
+// 회원 검색 (q + cursor, limit, sort, 필터)
+func (h *AdminHandler) SearchMembers(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	page, err := h.service.SearchMembers(ctx, c.Query("q"), c.Queries())
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 검색 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("회원 검색 성공", page))
+}
+
+// 회원 상세 조회
+func (h *AdminHandler) GetMember(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	member, err := h.service.GetMember(ctx, memberID)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("회원 조회 성공", member))
+}
+
+// 회원 상태 변경 (비활성화 / 활성화)
+func (h *AdminHandler) UpdateStatus(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	var req UpdateStatusRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	if req.Status == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
+	}
+
+	member, err := h.service.UpdateStatus(ctx, actorID(c), memberID, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 상태 변경 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("회원 상태 변경 성공", member))
+}
+
+// 회원 상태 변경 이력
+func (h *AdminHandler) StatusHistory(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	history, err := h.service.StatusHistory(ctx, memberID)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "상태 변경 이력 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("상태 변경 이력 조회 성공", history))
+}
+
+// 권한 부여
+func (h *AdminHandler) GrantRole(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	var req GrantRoleRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	if req.Role == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
+	}
+
+	member, err := h.service.GrantRole(ctx, actorID(c), memberID, req.Role)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "권한 부여 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("권한 부여 성공", member))
+}
+
+// 권한 회수
+func (h *AdminHandler) RevokeRole(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	member, err := h.service.RevokeRole(ctx, actorID(c), memberID, model.Role(c.Params("role")))
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "권한 회수 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("권한 회수 성공", member))
+}
+
+// 일괄 처리
+func (h *AdminHandler) Bulk(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req BulkRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	if req.Action == "" || len(req.MemberIDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
+	}
+
+	result, err := h.service.Bulk(ctx, actorID(c), &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "일괄 처리 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("일괄 처리 완료", result))
+}
+
+// 경로의 회원 ID
+func memberIDParam(c *fiber.Ctx) (int64, error) {
+	return strconv.ParseInt(c.Params("id"), 10, 64)
+}
+
+// 요청한 관리자 회원 ID (RequireRole 이후라 Claims 항상 존재)
+func actorID(c *fiber.Ctx) int64 {
+	return auth.ClaimsFrom(c).MemberID
+}
+
+// 서비스 에러 → HTTP 상태
+func errorStatus(err error) int {
+	switch err {
+	case ErrMemberNotFound:
+		return fiber.StatusNotFound
+	case ErrSelfModification, ErrMemberPending, lifecycle.ErrInvalidTransition:
+		return fiber.StatusConflict
+	default:
+		if isBusinessError(err) {
+			return fiber.StatusBadRequest
+		}
+		return fiber.StatusInternalServerError
+	}
+}
+
+// 응답 에러 코드 (내부 에러 내용은 노출하지 않음)
+func errorCode(err error) string {
+	if isBusinessError(err) {
+		return err.Error()
+	}
+	return errorx.ErrInternal.Error()
+}
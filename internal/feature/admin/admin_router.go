@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type AdminRouter struct {
+	handler *AdminHandler
+}
+
+func NewAdminRouter(handler *AdminHandler) *AdminRouter {
+	return &AdminRouter{handler: handler}
+}
+
+// 관리자 권한 확인 이후
+func (r *AdminRouter) RegisterRoutes(
+	admin fiber.Router,
+) {
+	members := admin.Group("/members")
+
+	members.Get("", r.handler.ListMembers)
+	members.Get("/search", r.handler.SearchMembers)
+	members.Post("/bulk", r.handler.Bulk)
+	members.Get("/:id", r.handler.GetMember)
+	members.Patch("/:id/status", r.handler.UpdateStatus)
+	members.Get("/:id/status-history", r.handler.StatusHistory)
+	members.Post("/:id/roles", r.handler.GrantRole)
+	members.Delete("/:id/roles/:role", r.handler.RevokeRole)
+}
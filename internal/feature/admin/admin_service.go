@@ -0,0 +1,561 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+
+	"study/internal/feature/lifecycle"
+	"study/internal/feature/preference"
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/errorx"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/internal/shared/pagination"
+	"study/internal/shared/profileimage"
+	"study/internal/shared/search"
+	"study/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 감사 로그 action
+const (
+	auditStatusChange = "STATUS_CHANGE"
+	auditRoleGrant    = "ROLE_GRANT"
+	auditRoleRevoke   = "ROLE_REVOKE"
+)
+
+// 일괄 처리 action
+const (
+	BulkDisable    = "disable"
+	BulkEnable     = "enable"
+	BulkGrantRole  = "grantRole"
+	BulkRevokeRole = "revokeRole"
+
+	// 일괄 처리 최대 회원 수
+	maxBulkSize = 100
+)
+
+// AdminService
+// - 관리자 회원 관리 전용 (조회, 상태 변경, 권한 부여/회수, 일괄 처리)
+// - 모든 변경은 같은 트랜잭션에서 member_audit_logs 에 기록 (상태 변경은 member_status_history 에도 기록)
+type AdminService struct {
+	pool        *pgxpool.Pool
+	queries     *query.Queries
+	lifecycle   *lifecycle.LifecycleService
+	profiles    *profileimage.Resolver
+	preferences *preference.PreferenceService
+}
+
+// 생성자
+func NewAdminService(pool *pgxpool.Pool, queries *query.Queries, lifecycleService *lifecycle.LifecycleService, profiles *profileimage.Resolver, preferences *preference.PreferenceService) *AdminService {
+	return &AdminService{pool: pool, queries: queries, lifecycle: lifecycleService, profiles: profiles, preferences: preferences}
+}
+
+// 회원 목록 조회 (필터, 정렬, 페이지)
+func (s *AdminService) ListMembers(ctx context.Context, req *MemberListQuery) (resp *MemberListResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminListMembers")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	params, page, size, err := parseListQuery(req)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	rows, err := s.queries.ListAdminMembers(ctx, params)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	total, err := s.queries.CountAdminMembers(ctx, countParams(params))
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 페이지 회원 권한 한 번에 조회
+	roles := map[int64][]model.Role{}
+	if len(rows) > 0 {
+		memberIDs := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			memberIDs = append(memberIDs, row.MemberID)
+		}
+
+		memberRoles, err := s.queries.ListRolesByMemberIDs(ctx, memberIDs)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		for _, r := range memberRoles {
+			roles[r.MemberID] = append(roles[r.MemberID], r.Role)
+		}
+	}
+
+	items := make([]MemberResponse, 0, len(rows))
+	for _, row := range rows {
+		image, err := s.profiles.Resolve(ctx, row.MemberID, row.Profile)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+
+		items = append(items, MemberResponse{
+			ID:                row.MemberID,
+			Email:             row.Email,
+			Name:              row.Name,
+			Tel:               mapper.TextPtr(row.Tel),
+			Address:           mapper.TextPtr(row.Address),
+			ProfileImage:      image,
+			Status:            row.Status,
+			Roles:             rolesOrEmpty(roles[row.MemberID]),
+			CreatedAt:         mapper.TimeValue(row.CreatedAt),
+			UpdatedAt:         mapper.TimePtr(row.UpdatedAt),
+			DeletedAt:         mapper.TimePtr(row.DeletedAt),
+			PasswordChangedAt: mapper.TimePtr(row.PasswordChangedAt),
+		})
+	}
+
+	span.SetAttributes(
+		attribute.Int("admin.page", page),
+		attribute.Int64("admin.total", total),
+	)
+
+	return &MemberListResponse{Items: items, Page: page, Size: size, Total: total}, nil
+}
+
+// 회원 검색 (이름 / 이메일 부분 일치, 유사도, 한글 2-gram, 전문 검색 순위)
+// - 탈퇴 회원 제외
+func (s *AdminService) SearchMembers(ctx context.Context, q string, params map[string]string) (resp *pagination.Page[MemberSearchResponse], err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminSearchMembers")
+	defer observability.EndSpanWithLatency(span, start, 300)
+
+	sq, err := search.Parse(q)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	req, err := pagination.Parse(searchSpec, params)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	where, args := req.Where(query.SearchMembersArgStart)
+	rows, err := s.queries.SearchMembers(ctx, query.SearchMembersParams{
+		Query:      sq.Text,
+		Pattern:    sq.Pattern,
+		Ngrams:     sq.Ngrams,
+		TsQuery:    sq.TsQuery,
+		MatchEmail: true,
+		Where:      where,
+		Args:       args,
+		OrderBy:    req.OrderBy(),
+		Limit:      req.FetchLimit(),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	items := make([]MemberSearchResponse, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, MemberSearchResponse{
+			ID:        row.MemberID,
+			Email:     row.Email,
+			Name:      row.Name,
+			Status:    row.Status,
+			CreatedAt: mapper.TimeValue(row.CreatedAt),
+			Rank:      row.Rank,
+			Highlight: SearchHighlight{
+				Name:  search.Highlight(row.Name, sq),
+				Email: search.Highlight(row.Email, sq),
+			},
+		})
+	}
+
+	span.SetAttributes(attribute.Int("admin.search_count", len(items)))
+
+	return pagination.NewPage(req, items, searchSortKey)
+}
+
+// 검색 결과 → 정렬 키 값 (커서)
+func searchSortKey(m MemberSearchResponse, name string) any {
+	switch name {
+	case "rank":
+		return m.Rank
+	case "name":
+		return m.Name
+	case "email":
+		return m.Email
+	case "createdAt":
+		return m.CreatedAt
+	default:
+		return m.ID
+	}
+}
+
+// 회원 상세 조회 (마케팅 이메일 수신 여부 포함)
+func (s *AdminService) GetMember(ctx context.Context, memberID int64) (resp *MemberResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminGetMember")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	resp, err = s.findMember(ctx, memberID)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	marketing, err := s.preferences.AllowsMarketing(ctx, memberID, preference.ChannelEmail)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+	resp.MarketingEmail = &marketing
+
+	span.SetAttributes(attribute.Int64("member.id", memberID))
+	return resp, nil
+}
+
+// 회원 상태 변경 (비활성화 시 세션 / 불투명 토큰 폐기)
+func (s *AdminService) UpdateStatus(ctx context.Context, actorID int64, memberID int64, req *UpdateStatusRequest) (resp *MemberResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminUpdateStatus")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	if err = s.changeStatus(ctx, actorID, memberID, req.Status, req.Note); err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("admin.actor_id", actorID),
+		attribute.Int64("member.id", memberID),
+		attribute.String("member.status", string(req.Status)),
+	)
+
+	return s.findMember(ctx, memberID)
+}
+
+// 회원 상태 변경 이력 조회 (삭제된 회원 포함, 최신순)
+func (s *AdminService) StatusHistory(ctx context.Context, memberID int64) (resp []lifecycle.HistoryResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminStatusHistory")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	if _, err = s.queries.FindMemberByID(ctx, memberID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = ErrMemberNotFound
+		}
+		recordError(span, err)
+		return nil, err
+	}
+
+	resp, err = s.lifecycle.History(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int64("member.id", memberID))
+	return resp, nil
+}
+
+// 권한 부여
+func (s *AdminService) GrantRole(ctx context.Context, actorID int64, memberID int64, role model.Role) (resp *MemberResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminGrantRole")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	if err = s.changeRole(ctx, actorID, memberID, role, true); err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("admin.actor_id", actorID),
+		attribute.Int64("member.id", memberID),
+		attribute.String("member.role", string(role)),
+	)
+
+	return s.findMember(ctx, memberID)
+}
+
+// 권한 회수
+func (s *AdminService) RevokeRole(ctx context.Context, actorID int64, memberID int64, role model.Role) (resp *MemberResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminRevokeRole")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	if err = s.changeRole(ctx, actorID, memberID, role, false); err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("admin.actor_id", actorID),
+		attribute.Int64("member.id", memberID),
+		attribute.String("member.role", string(role)),
+	)
+
+	return s.findMember(ctx, memberID)
+}
+
+// 일괄 처리 (회원별 트랜잭션, 일부 실패해도 나머지 계속)
+func (s *AdminService) Bulk(ctx context.Context, actorID int64, req *BulkRequest) (resp *BulkResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "AdminBulk")
+	defer observability.EndSpanWithLatency(span, start, 1000)
+
+	var apply func(memberID int64) error
+	switch req.Action {
+	case BulkDisable:
+		apply = func(memberID int64) error {
+			return s.changeStatus(ctx, actorID, memberID, model.StatusDisabled, req.Note)
+		}
+	case BulkEnable:
+		apply = func(memberID int64) error {
+			return s.changeStatus(ctx, actorID, memberID, model.StatusActive, req.Note)
+		}
+	case BulkGrantRole:
+		apply = func(memberID int64) error { return s.changeRole(ctx, actorID, memberID, req.Role, true) }
+	case BulkRevokeRole:
+		apply = func(memberID int64) error { return s.changeRole(ctx, actorID, memberID, req.Role, false) }
+	default:
+		observability.RecordBusinessError(span, ErrInvalidBulkAction)
+		return nil, ErrInvalidBulkAction
+	}
+
+	// 권한 처리는 대상 권한을 먼저 검증 (회원마다 같은 에러 반복 방지)
+	if (req.Action == BulkGrantRole || req.Action == BulkRevokeRole) && !slices.Contains(model.Roles, req.Role) {
+		observability.RecordBusinessError(span, ErrInvalidRole)
+		return nil, ErrInvalidRole
+	}
+
+	memberIDs := slices.Compact(slices.Sorted(slices.Values(req.MemberIDs)))
+	if len(memberIDs) == 0 || len(memberIDs) > maxBulkSize {
+		observability.RecordBusinessError(span, ErrInvalidBulkTarget)
+		return nil, ErrInvalidBulkTarget
+	}
+
+	resp = &BulkResponse{Action: req.Action, Results: make([]BulkResult, 0, len(memberIDs))}
+	for _, memberID := range memberIDs {
+		if err := apply(memberID); err != nil {
+			code := err.Error()
+			if !isBusinessError(err) {
+				log.ErrorCtx(ctx, "관리자 일괄 처리 실패", log.MapInt64("memberId", memberID), log.MapErr("error", err))
+				code = errorx.ErrInternal.Error()
+			}
+			resp.Failed++
+			resp.Results = append(resp.Results, BulkResult{MemberID: memberID, Code: code})
+			continue
+		}
+		resp.Succeeded++
+		resp.Results = append(resp.Results, BulkResult{MemberID: memberID, Success: true})
+	}
+
+	span.SetAttributes(
+		attribute.Int64("admin.actor_id", actorID),
+		attribute.String("admin.bulk_action", req.Action),
+		attribute.Int("admin.bulk_succeeded", resp.Succeeded),
+		attribute.Int("admin.bulk_failed", resp.Failed),
+	)
+
+	return resp, nil
+}
+
+// 회원 + 권한 조회
+func (s *AdminService) findMember(ctx context.Context, memberID int64) (*MemberResponse, error) {
+	m, err := s.queries.FindMemberByID(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := s.queries.GetRolesByMemberID(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := s.profiles.Resolve(ctx, m.MemberID, m.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberResponse{
+		ID:                m.MemberID,
+		Email:             m.Email,
+		Name:              m.Name,
+		Tel:               mapper.TextPtr(m.Tel),
+		Address:           mapper.TextPtr(m.Address),
+		ProfileImage:      image,
+		Status:            m.Status,
+		Roles:             rolesOrEmpty(roles),
+		CreatedAt:         mapper.TimeValue(m.CreatedAt),
+		UpdatedAt:         mapper.TimePtr(m.UpdatedAt),
+		DeletedAt:         mapper.TimePtr(m.DeletedAt),
+		PasswordChangedAt: mapper.TimePtr(m.PasswordChangedAt),
+	}, nil
+}
+
+// 상태 변경 + 감사 기록 (같은 상태면 기록 없이 성공)
+// - 전이 검증 / 상태 이력 / 세션 폐기는 lifecycle 에서 처리
+func (s *AdminService) changeStatus(ctx context.Context, actorID int64, memberID int64, status model.Status, note string) error {
+	if status != model.StatusActive && status != model.StatusDisabled {
+		return ErrInvalidStatus
+	}
+	if actorID == memberID && status == model.StatusDisabled {
+		return ErrSelfModification
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	if _, err = findActiveOrDisabled(ctx, transaction, memberID); err != nil {
+		return err
+	}
+
+	change := &lifecycle.Change{
+		MemberID: memberID,
+		To:       status,
+		Reason:   lifecycle.ReasonAdminAction,
+		Note:     note,
+		Actor:    lifecycle.AdminActor(strconv.FormatInt(actorID, 10)),
+	}
+	changed, err := s.lifecycle.Transition(ctx, transaction, change)
+	if errors.Is(err, lifecycle.ErrMemberNotFound) {
+		return ErrMemberNotFound
+	}
+	if err != nil || !changed {
+		return err
+	}
+
+	detail := string(change.From) + "->" + string(change.To)
+	if err = audit(ctx, transaction, actorID, memberID, auditStatusChange, detail); err != nil {
+		return err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	log.InfoCtx(ctx, "관리자 회원 상태 변경", log.MapInt64("actorId", actorID), log.MapInt64("memberId", memberID), log.MapStr("detail", detail))
+	return nil
+}
+
+// 권한 부여/회수 + 감사 기록 (이미 반영된 상태면 기록 없이 성공)
+func (s *AdminService) changeRole(ctx context.Context, actorID int64, memberID int64, role model.Role, grant bool) error {
+	if !slices.Contains(model.Roles, role) {
+		return ErrInvalidRole
+	}
+	if !grant && actorID == memberID && role == model.RoleAdmin {
+		return ErrSelfModification
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	if _, err = findActiveOrDisabled(ctx, transaction, memberID); err != nil {
+		return err
+	}
+
+	roles, err := transaction.GetRolesByMemberID(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(roles, role) == grant {
+		return nil
+	}
+
+	action := auditRoleGrant
+	if grant {
+		err = transaction.InsertMemberRole(ctx, query.InsertMemberRoleParams{MemberID: memberID, Role: role})
+	} else {
+		action = auditRoleRevoke
+		err = transaction.DeleteMemberRole(ctx, query.DeleteMemberRoleParams{MemberID: memberID, Role: role})
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = audit(ctx, transaction, actorID, memberID, action, string(role)); err != nil {
+		return err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	log.InfoCtx(ctx, "관리자 회원 권한 변경", log.MapInt64("actorId", actorID), log.MapInt64("memberId", memberID), log.MapStr("action", action), log.MapStr("role", string(role)))
+	return nil
+}
+
+// 변경 대상 회원 조회 (삭제된 회원은 없음으로 처리, 승인 대기 회원은 가입 승인 API 로만 처리)
+func findActiveOrDisabled(ctx context.Context, q *query.Queries, memberID int64) (query.Member, error) {
+	m, err := q.FindMemberByID(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && m.DeletedAt.Valid) {
+		return query.Member{}, ErrMemberNotFound
+	}
+	if err == nil && m.Status == model.StatusReady {
+		return query.Member{}, ErrMemberPending
+	}
+	return m, err
+}
+
+// 감사 로그 기록
+func audit(ctx context.Context, q *query.Queries, actorID int64, memberID int64, action string, detail string) error {
+	return q.InsertMemberAuditLog(ctx, query.InsertMemberAuditLogParams{
+		ActorID:  actorID,
+		MemberID: memberID,
+		Action:   action,
+		Detail:   detail,
+	})
+}
+
+// 권한 없는 회원도 [] 로 응답
+func rolesOrEmpty(roles []model.Role) []model.Role {
+	if roles == nil {
+		return []model.Role{}
+	}
+	return roles
+}
+
+// 요청 오류(비즈니스 에러) 여부
+func isBusinessError(err error) bool {
+	switch err {
+	case ErrMemberNotFound, ErrMemberPending, ErrInvalidQuery, ErrInvalidStatus, ErrInvalidRole, ErrSelfModification, ErrInvalidBulkAction, ErrInvalidBulkTarget,
+		lifecycle.ErrInvalidTransition, lifecycle.ErrInvalidReason,
+		search.ErrInvalidQuery, pagination.ErrInvalidLimit, pagination.ErrInvalidSort, pagination.ErrInvalidFilter, pagination.ErrInvalidCursor:
+		return true
+	default:
+		return false
+	}
+}
+
+// span 에 에러 기록 (비즈니스 / 서비스 에러 구분)
+func recordError(span trace.Span, err error) {
+	if isBusinessError(err) {
+		observability.RecordBusinessError(span, err)
+		return
+	}
+	observability.RecordServiceError(span, err)
+}
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"time"
+
+	"study/internal/shared/model"
+	"study/internal/shared/profileimage"
+)
+
+// 회원 목록 조회 조건 (쿼리 파라미터)
+type MemberListQuery struct {
+	Page        int    `query:"page"`        // 1부터 시작 (기본 1)
+	Size        int    `query:"size"`        // 기본 20, 최대 100
+	Sort        string `query:"sort"`        // id | email | name | createdAt (기본 id)
+	Order       string `query:"order"`       // asc | desc (기본 asc)
+	Status      string `query:"status"`      // READY | ACTIVE | DISABLED | DELETED
+	Role        string `query:"role"`        // USER | ADMIN
+	CreatedFrom string `query:"createdFrom"` // YYYY-MM-DD (포함)
+	CreatedTo   string `query:"createdTo"`   // YYYY-MM-DD (포함)
+	Search      string `query:"q"`           // 이메일 / 이름 부분 일치
+}
+
+// 회원 상태 변경 요청 DTO
+type UpdateStatusRequest struct {
+	Status model.Status `json:"status"`         // ACTIVE | DISABLED
+	Note   string       `json:"note,omitempty"` // 변경 사유 메모 (상태 이력에 기록, 최대 500자)
+}
+
+// 권한 부여 요청 DTO
+type GrantRoleRequest struct {
+	Role model.Role `json:"role"`
+}
+
+// 일괄 처리 요청 DTO
+type BulkRequest struct {
+	Action    string     `json:"action"` // disable | enable | grantRole | revokeRole
+	MemberIDs []int64    `json:"memberIds"`
+	Role      model.Role `json:"role,omitempty"` // grantRole / revokeRole 대상 권한
+	Note      string     `json:"note,omitempty"` // disable / enable 사유 메모
+}
+
+// 관리자용 회원 응답 DTO
+type MemberResponse struct {
+	ID                int64                  `json:"id"`
+	Email             string                 `json:"email"`
+	Name              string                 `json:"name"`
+	Tel               *string                `json:"tel"`
+	Address           *string                `json:"address"`
+	ProfileImage      *profileimage.Response `json:"profileImage"` // 기간 제한 다운로드 URL
+	Status            model.Status           `json:"status"`
+	Roles             []model.Role           `json:"roles"`
+	CreatedAt         time.Time              `json:"createdAt"`
+	UpdatedAt         *time.Time             `json:"updatedAt"`
+	DeletedAt         *time.Time             `json:"deletedAt"`
+	PasswordChangedAt *time.Time             `json:"passwordChangedAt"`
+	MarketingEmail    *bool                  `json:"marketingEmail,omitempty"` // 마케팅 이메일 수신 여부 (상세 조회만)
+}
+
+// 회원 목록 응답 DTO
+type MemberListResponse struct {
+	Items []MemberResponse `json:"items"`
+	Page  int              `json:"page"`
+	Size  int              `json:"size"`
+	Total int64            `json:"total"`
+}
+
+// 일괄 처리 회원별 결과
+type BulkResult struct {
+	MemberID int64  `json:"memberId"`
+	Success  bool   `json:"success"`
+	Code     string `json:"code,omitempty"` // 실패 시 에러 코드
+}
+
+// 일괄 처리 응답 DTO
+type BulkResponse struct {
+	Action    string       `json:"action"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Results   []BulkResult `json:"results"`
+}
+
+// 회원 검색 결과 DTO
+type MemberSearchResponse struct {
+	ID        int64           `json:"id"`
+	Email     string          `json:"email"`
+	Name      string          `json:"name"`
+	Status    model.Status    `json:"status"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Rank      float64         `json:"rank"`
+	Highlight SearchHighlight `json:"highlight"`
+}
+
+// 검색어 일치 부분 강조 (<em>, HTML 이스케이프)
+type SearchHighlight struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
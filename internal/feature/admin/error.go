@@ -0,0 +1,30 @@
+package admin
+
+import "errors"
+
+// 서비스 에러
+var (
+	// 회원 없음 (삭제된 회원 변경 포함)
+	ErrMemberNotFound = errors.New("MEMBER_NOT_FOUND")
+
+	// 승인 대기 회원 (가입 승인 / 거절로만 처리)
+	ErrMemberPending = errors.New("MEMBER_PENDING_APPROVAL")
+
+	// 목록 조회 조건 오류 (페이지, 정렬, 날짜 형식)
+	ErrInvalidQuery = errors.New("INVALID_QUERY")
+
+	// 변경할 수 없는 상태 값 (ACTIVE / DISABLED 만 허용)
+	ErrInvalidStatus = errors.New("INVALID_STATUS")
+
+	// 존재하지 않는 권한
+	ErrInvalidRole = errors.New("INVALID_ROLE")
+
+	// 본인 계정 비활성화 / 관리자 권한 회수 (관리자 잠김 방지)
+	ErrSelfModification = errors.New("SELF_MODIFICATION_FORBIDDEN")
+
+	// 지원하지 않는 일괄 처리
+	ErrInvalidBulkAction = errors.New("INVALID_BULK_ACTION")
+
+	// 일괄 처리 대상 없음 / 개수 초과
+	ErrInvalidBulkTarget = errors.New("INVALID_BULK_TARGET")
+)
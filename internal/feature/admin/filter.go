@@ -0,0 +1,149 @@
+package admin
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/internal/shared/pagination"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// 목록 조회 기본/최대 개수
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// 날짜 조건 형식
+	dateLayout = "2006-01-02"
+)
+
+// 정렬 파라미터 → 정렬 컬럼
+var sortKeys = map[string]string{
+	"id":        "member_id",
+	"email":     "email",
+	"name":      "name",
+	"createdAt": "created_at",
+}
+
+// 회원 검색 (q 외 pagination 파라미터)
+// - sort: rank(기본, 내림차순) | id | name | email | createdAt
+// - filter: status, status[in], createdAt[gte|lt]
+var searchSpec = &pagination.Spec{
+	DefaultLimit: defaultPageSize,
+	MaxLimit:     maxPageSize,
+	Sorts: map[string]pagination.Field{
+		"rank":      {Column: query.SearchColumnRank, Type: pagination.TypeFloat},
+		"id":        {Column: query.SearchColumnMemberID, Type: pagination.TypeInt},
+		"name":      {Column: query.SearchColumnName, Type: pagination.TypeString},
+		"email":     {Column: query.SearchColumnEmail, Type: pagination.TypeString},
+		"createdAt": {Column: query.SearchColumnCreatedAt, Type: pagination.TypeTime},
+	},
+	DefaultSort: "-rank",
+	Key:         "id",
+	Filters: map[string]pagination.Filter{
+		"status": {
+			Field: pagination.Field{Column: query.SearchColumnStatus, Type: pagination.TypeString},
+			Ops:   []pagination.Op{pagination.OpEq, pagination.OpIn},
+		},
+		"createdAt": {
+			Field: pagination.Field{Column: query.SearchColumnCreatedAt, Type: pagination.TypeTime},
+			Ops:   []pagination.Op{pagination.OpGte, pagination.OpLt},
+		},
+	},
+}
+
+// 조회 가능한 회원 상태
+var statuses = []model.Status{model.StatusReady, model.StatusActive, model.StatusDisabled, model.StatusDeleted}
+
+// LIKE 특수문자 이스케이프 (검색어는 부분 일치 문자열로만 사용)
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// 목록 조회 조건 → 쿼리 파라미터 (page, size 보정 포함)
+func parseListQuery(q *MemberListQuery) (params query.ListAdminMembersParams, page int, size int, err error) {
+	page, size = q.Page, q.Size
+	if page == 0 {
+		page = 1
+	}
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if page < 1 || size < 1 || size > maxPageSize || int64(page-1)*int64(size) > math.MaxInt32 {
+		return params, 0, 0, ErrInvalidQuery
+	}
+
+	sortKey := "member_id"
+	if q.Sort != "" {
+		key, ok := sortKeys[q.Sort]
+		if !ok {
+			return params, 0, 0, ErrInvalidQuery
+		}
+		sortKey = key
+	}
+
+	switch strings.ToLower(q.Order) {
+	case "", "asc":
+	case "desc":
+		params.SortDesc = true
+	default:
+		return params, 0, 0, ErrInvalidQuery
+	}
+
+	if q.Status != "" {
+		if !slices.Contains(statuses, model.Status(q.Status)) {
+			return params, 0, 0, ErrInvalidStatus
+		}
+		params.Status = pgtype.Text{String: q.Status, Valid: true}
+	}
+
+	if q.Role != "" {
+		if !slices.Contains(model.Roles, model.Role(q.Role)) {
+			return params, 0, 0, ErrInvalidRole
+		}
+		params.Role = pgtype.Text{String: q.Role, Valid: true}
+	}
+
+	// 날짜 범위는 양 끝 포함 (createdTo 는 다음 날 0시 미만)
+	if q.CreatedFrom != "" {
+		from, err := time.Parse(dateLayout, q.CreatedFrom)
+		if err != nil {
+			return params, 0, 0, ErrInvalidQuery
+		}
+		params.CreatedFrom = pgtype.Timestamp{Time: from, Valid: true}
+	}
+	if q.CreatedTo != "" {
+		to, err := time.Parse(dateLayout, q.CreatedTo)
+		if err != nil {
+			return params, 0, 0, ErrInvalidQuery
+		}
+		params.CreatedTo = pgtype.Timestamp{Time: to.AddDate(0, 0, 1), Valid: true}
+	}
+	if params.CreatedFrom.Valid && params.CreatedTo.Valid && !params.CreatedFrom.Time.Before(params.CreatedTo.Time) {
+		return params, 0, 0, ErrInvalidQuery
+	}
+
+	if search := strings.TrimSpace(q.Search); search != "" {
+		params.Search = pgtype.Text{String: likeEscaper.Replace(search), Valid: true}
+	}
+
+	params.SortKey = sortKey
+	params.LimitCount = int32(size)
+	params.OffsetCount = int32((page - 1) * size)
+
+	return params, page, size, nil
+}
+
+// 목록 조건 → 전체 개수 조건
+func countParams(params query.ListAdminMembersParams) query.CountAdminMembersParams {
+	return query.CountAdminMembersParams{
+		Status:      params.Status,
+		Role:        params.Role,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		Search:      params.Search,
+	}
+}
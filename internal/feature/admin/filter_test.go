@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseListQuery(t *testing.T) {
+	params, page, size, err := parseListQuery(&MemberListQuery{})
+	if err != nil {
+		t.Fatalf("기본 조건 파싱 실패: %v", err)
+	}
+	if page != 1 || size != defaultPageSize || params.SortKey != "member_id" || params.SortDesc || params.OffsetCount != 0 {
+		t.Errorf("기본값 불일치: page=%d size=%d params=%+v", page, size, params)
+	}
+
+	params, page, size, err = parseListQuery(&MemberListQuery{
+		Page:        3,
+		Size:        10,
+		Sort:        "createdAt",
+		Order:       "DESC",
+		Status:      "DISABLED",
+		Role:        "ADMIN",
+		CreatedFrom: "2025-01-01",
+		CreatedTo:   "2025-01-31",
+		Search:      " 50%_off\\ ",
+	})
+	if err != nil {
+		t.Fatalf("조건 파싱 실패: %v", err)
+	}
+	if page != 3 || size != 10 || params.LimitCount != 10 || params.OffsetCount != 20 {
+		t.Errorf("페이지 계산 불일치: page=%d size=%d params=%+v", page, size, params)
+	}
+	if params.SortKey != "created_at" || !params.SortDesc {
+		t.Errorf("정렬 불일치: %s desc=%v", params.SortKey, params.SortDesc)
+	}
+	if params.Status.String != "DISABLED" || params.Role.String != "ADMIN" {
+		t.Errorf("필터 불일치: %+v %+v", params.Status, params.Role)
+	}
+	// createdTo 는 해당 날짜 포함 (다음 날 0시 미만)
+	if !params.CreatedTo.Time.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("createdTo 불일치: %v", params.CreatedTo.Time)
+	}
+	// LIKE 특수문자 이스케이프
+	if params.Search.String != `50\%\_off\\` {
+		t.Errorf("검색어 이스케이프 불일치: %q", params.Search.String)
+	}
+
+	invalid := []struct {
+		name  string
+		query MemberListQuery
+		err   error
+	}{
+		{"페이지 음수", MemberListQuery{Page: -1}, ErrInvalidQuery},
+		{"크기 초과", MemberListQuery{Size: maxPageSize + 1}, ErrInvalidQuery},
+		{"정렬 컬럼", MemberListQuery{Sort: "password"}, ErrInvalidQuery},
+		{"정렬 방향", MemberListQuery{Order: "random"}, ErrInvalidQuery},
+		{"상태", MemberListQuery{Status: "UNKNOWN"}, ErrInvalidStatus},
+		{"권한", MemberListQuery{Role: "ROOT"}, ErrInvalidRole},
+		{"날짜 형식", MemberListQuery{CreatedFrom: "2025/01/01"}, ErrInvalidQuery},
+		{"날짜 범위", MemberListQuery{CreatedFrom: "2025-02-01", CreatedTo: "2025-01-01"}, ErrInvalidQuery},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, _, err := parseListQuery(&tc.query); err != tc.err {
+				t.Errorf("검증 결과 불일치: %v, 기대: %v", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package approval
+
+import (
+	"strconv"
+
+	"study/internal/feature/auth"
+	"study/internal/shared/errorx"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Handler
+type ApprovalHandler struct {
+	service *ApprovalService
+}
+
+func NewApprovalHandler(service *ApprovalService) *ApprovalHandler {
+	return &ApprovalHandler{service: service}
+}
+
+// 승인 대기 목록 (cursor, limit, sort, 필터)
+func (h *ApprovalHandler) ListPending(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	page, err := h.service.ListPending(ctx, c.Queries())
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "승인 대기 목록 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("승인 대기 목록 조회 성공", page))
+}
+
+// 가입 승인 (본문 생략 가능)
+func (h *ApprovalHandler) Approve(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	var req DecisionRequest
+
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+		}
+	}
+
+	result, err := h.service.Approve(ctx, actorID(c), memberID, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "가입 승인 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("가입 승인 성공", result))
+}
+
+// 가입 거절 (사유 필수)
+func (h *ApprovalHandler) Reject(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	memberID, err := memberIDParam(c)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrMemberNotFound.Error(), "회원 없음", nil))
+	}
+
+	var req DecisionRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	result, err := h.service.Reject(ctx, actorID(c), memberID, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "가입 거절 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("가입 거절 성공", result))
+}
+
+// 경로의 회원 ID
+func memberIDParam(c *fiber.Ctx) (int64, error) {
+	return strconv.ParseInt(c.Params("id"), 10, 64)
+}
+
+// 요청한 관리자 회원 ID (RequireRole 이후라 Claims 항상 존재)
+func actorID(c *fiber.Ctx) int64 {
+	return auth.ClaimsFrom(c).MemberID
+}
+
+// 서비스 에러 → HTTP 상태
+func errorStatus(err error) int {
+	switch err {
+	case ErrMemberNotFound:
+		return fiber.StatusNotFound
+	case ErrNotPending:
+		return fiber.StatusConflict
+	default:
+		if isBusinessError(err) {
+			return fiber.StatusBadRequest
+		}
+		return fiber.StatusInternalServerError
+	}
+}
+
+// 응답 에러 코드 (내부 에러 내용은 노출하지 않음)
+func errorCode(err error) string {
+	if isBusinessError(err) {
+		return err.Error()
+	}
+	return errorx.ErrInternal.Error()
+}
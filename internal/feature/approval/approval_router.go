@@ -0,0 +1,24 @@
+package approval
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type ApprovalRouter struct {
+	handler *ApprovalHandler
+}
+
+func NewApprovalRouter(handler *ApprovalHandler) *ApprovalRouter {
+	return &ApprovalRouter{handler: handler}
+}
+
+// 관리자 권한 확인 이후
+func (r *ApprovalRouter) RegisterRoutes(
+	admin fiber.Router,
+) {
+	signups := admin.Group("/signups")
+
+	signups.Get("", r.handler.ListPending)
+	signups.Post("/:id/approve", r.handler.Approve)
+	signups.Post("/:id/reject", r.handler.Reject)
+}
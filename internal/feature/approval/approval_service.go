@@ -0,0 +1,245 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"study/internal/feature/lifecycle"
+	"study/internal/mail"
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/internal/shared/pagination"
+	"study/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 감사 로그 action
+const (
+	auditSignupApprove = "SIGNUP_APPROVE"
+	auditSignupReject  = "SIGNUP_REJECT"
+)
+
+// ApprovalService
+// - 가입 승인 대기(READY) 회원 승인 / 거절 (signup.mode: approval)
+// - 승인 READY → ACTIVE, 거절 READY → DELETED (lifecycle 전이 + 상태 이력 + 감사 로그)
+// - 안내 메일은 커밋 이후 발송 (실패해도 결정은 유지, 응답의 notified 로 확인)
+// - 안내 메일은 계정 메일이라 회원 알림 설정(notificationChannels)과 무관하게 발송
+type ApprovalService struct {
+	pool      *pgxpool.Pool
+	queries   *query.Queries
+	lifecycle *lifecycle.LifecycleService
+	mailer    mail.Sender
+}
+
+// 생성자
+func NewApprovalService(pool *pgxpool.Pool, queries *query.Queries, lifecycleService *lifecycle.LifecycleService, mailer mail.Sender) *ApprovalService {
+	return &ApprovalService{
+		pool:      pool,
+		queries:   queries,
+		lifecycle: lifecycleService,
+		mailer:    mailer,
+	}
+}
+
+// 승인 대기 목록 (기본 오래된 순)
+func (s *ApprovalService) ListPending(ctx context.Context, params map[string]string) (resp *pagination.Page[PendingSignupResponse], err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ApprovalListPending")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	req, err := pagination.Parse(queueSpec, params)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	where, args := req.Where(query.ListPendingSignupsArgStart)
+	rows, err := s.queries.ListPendingSignups(ctx, query.ListPendingSignupsParams{
+		Where:   where,
+		Args:    args,
+		OrderBy: req.OrderBy(),
+		Limit:   req.FetchLimit(),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	items := make([]PendingSignupResponse, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, PendingSignupResponse{
+			ID:        row.MemberID,
+			Email:     row.Email,
+			Name:      row.Name,
+			CreatedAt: mapper.TimeValue(row.CreatedAt),
+		})
+	}
+
+	span.SetAttributes(attribute.Int("approval.pending_count", len(items)))
+
+	return pagination.NewPage(req, items, queueSortKey)
+}
+
+// 가입 승인 (사유는 선택)
+func (s *ApprovalService) Approve(ctx context.Context, actorID int64, memberID int64, req *DecisionRequest) (resp *DecisionResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ApprovalApprove")
+	defer observability.EndSpanWithLatency(span, start, 300)
+
+	reason := strings.TrimSpace(req.Reason)
+
+	m, err := s.decide(ctx, actorID, memberID, model.StatusActive, reason)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	subject, text := approvedMail(m.Name)
+	resp = &DecisionResponse{
+		ID:       memberID,
+		Status:   model.StatusActive,
+		Notified: s.notify(ctx, m, subject, text),
+	}
+
+	span.SetAttributes(
+		attribute.Int64("admin.actor_id", actorID),
+		attribute.Int64("member.id", memberID),
+		attribute.Bool("approval.notified", resp.Notified),
+	)
+
+	return resp, nil
+}
+
+// 가입 거절 (사유 필수, 회원 안내 메일에 포함)
+func (s *ApprovalService) Reject(ctx context.Context, actorID int64, memberID int64, req *DecisionRequest) (resp *DecisionResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ApprovalReject")
+	defer observability.EndSpanWithLatency(span, start, 300)
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		observability.RecordBusinessError(span, ErrInvalidReason)
+		return nil, ErrInvalidReason
+	}
+
+	m, err := s.decide(ctx, actorID, memberID, model.StatusDeleted, reason)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	subject, text := rejectedMail(m.Name, reason)
+	resp = &DecisionResponse{
+		ID:       memberID,
+		Status:   model.StatusDeleted,
+		Notified: s.notify(ctx, m, subject, text),
+	}
+
+	span.SetAttributes(
+		attribute.Int64("admin.actor_id", actorID),
+		attribute.Int64("member.id", memberID),
+		attribute.Bool("approval.notified", resp.Notified),
+	)
+
+	return resp, nil
+}
+
+// 승인 / 거절 공통 (READY 회원만, 동시 처리는 lifecycle 의 From 확인으로 한 번만 성공)
+func (s *ApprovalService) decide(ctx context.Context, actorID int64, memberID int64, to model.Status, reason string) (query.Member, error) {
+	reasonCode, action := lifecycle.ReasonSignupApproved, auditSignupApprove
+	if to == model.StatusDeleted {
+		reasonCode, action = lifecycle.ReasonSignupRejected, auditSignupReject
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return query.Member{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	m, err := transaction.FindMemberByID(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.Member{}, ErrMemberNotFound
+	}
+	if err != nil {
+		return query.Member{}, err
+	}
+	if m.Status != model.StatusReady {
+		return query.Member{}, ErrNotPending
+	}
+
+	_, err = s.lifecycle.Transition(ctx, transaction, &lifecycle.Change{
+		MemberID: memberID,
+		From:     model.StatusReady,
+		To:       to,
+		Reason:   reasonCode,
+		Note:     reason,
+		Actor:    lifecycle.AdminActor(strconv.FormatInt(actorID, 10)),
+	})
+	switch err {
+	case nil:
+	case lifecycle.ErrUnexpectedStatus:
+		return query.Member{}, ErrNotPending
+	case lifecycle.ErrInvalidReason:
+		return query.Member{}, ErrInvalidReason
+	case lifecycle.ErrMemberNotFound:
+		return query.Member{}, ErrMemberNotFound
+	default:
+		return query.Member{}, err
+	}
+
+	err = transaction.InsertMemberAuditLog(ctx, query.InsertMemberAuditLogParams{
+		ActorID:  actorID,
+		MemberID: memberID,
+		Action:   action,
+		Detail:   reason,
+	})
+	if err != nil {
+		return query.Member{}, err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		return query.Member{}, err
+	}
+
+	log.InfoCtx(ctx, "회원가입 승인 처리", log.MapInt64("actorId", actorID), log.MapInt64("memberId", memberID), log.MapStr("action", action))
+	return m, nil
+}
+
+// 안내 메일 발송 (실패는 로그만, 발송 여부 반환)
+func (s *ApprovalService) notify(ctx context.Context, m query.Member, subject string, text string) bool {
+	if err := s.mailer.Send(ctx, m.Email, subject, text); err != nil {
+		log.ErrorCtx(ctx, "가입 승인 안내 메일 발송 실패", log.MapInt64("memberId", m.MemberID), log.MapErr("error", err))
+		return false
+	}
+	return true
+}
+
+// 요청 오류(비즈니스 에러) 여부
+func isBusinessError(err error) bool {
+	switch err {
+	case ErrMemberNotFound, ErrNotPending, ErrInvalidReason,
+		pagination.ErrInvalidLimit, pagination.ErrInvalidSort, pagination.ErrInvalidFilter, pagination.ErrInvalidCursor:
+		return true
+	default:
+		return false
+	}
+}
+
+// span 에 에러 기록 (비즈니스 / 서비스 에러 구분)
+func recordError(span trace.Span, err error) {
+	if isBusinessError(err) {
+		observability.RecordBusinessError(span, err)
+		return
+	}
+	observability.RecordServiceError(span, err)
+}
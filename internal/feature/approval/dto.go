@@ -0,0 +1,27 @@
+package approval
+
+import (
+	"time"
+
+	"study/internal/shared/model"
+)
+
+// 승인 / 거절 요청 DTO
+type DecisionRequest struct {
+	Reason string `json:"reason"` // 거절 시 필수 (회원 안내 메일에 포함, 최대 500자)
+}
+
+// 승인 대기 회원 DTO
+type PendingSignupResponse struct {
+	ID        int64     `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// 승인 / 거절 결과 DTO
+type DecisionResponse struct {
+	ID       int64        `json:"id"`
+	Status   model.Status `json:"status"`   // ACTIVE (승인) | DELETED (거절)
+	Notified bool         `json:"notified"` // 안내 메일 발송 성공 여부
+}
@@ -0,0 +1,15 @@
+package approval
+
+import "errors"
+
+// 서비스 에러
+var (
+	// 회원 없음 (삭제된 회원 포함)
+	ErrMemberNotFound = errors.New("MEMBER_NOT_FOUND")
+
+	// 승인 대기(READY) 상태가 아님 (이미 처리된 가입 포함)
+	ErrNotPending = errors.New("SIGNUP_NOT_PENDING")
+
+	// 거절 사유 누락 / 사유 길이 초과
+	ErrInvalidReason = errors.New("INVALID_DECISION_REASON")
+)
@@ -0,0 +1,17 @@
+package approval
+
+import "fmt"
+
+// 가입 승인 안내 메일
+func approvedMail(name string) (subject string, text string) {
+	subject = "[Study] 회원가입이 승인되었습니다"
+	text = fmt.Sprintf("%s 님, 안녕하세요.\n\n회원가입이 승인되었습니다. 이제 가입하신 이메일로 로그인할 수 있습니다.\n", name)
+	return subject, text
+}
+
+// 가입 거절 안내 메일 (관리자 입력 사유 포함)
+func rejectedMail(name string, reason string) (subject string, text string) {
+	subject = "[Study] 회원가입이 거절되었습니다"
+	text = fmt.Sprintf("%s 님, 안녕하세요.\n\n요청하신 회원가입이 승인되지 않았습니다.\n\n사유: %s\n\n문의 사항은 관리자에게 연락해 주세요.\n", name, reason)
+	return subject, text
+}
@@ -0,0 +1,56 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"study/internal/query"
+)
+
+// 발송 내역 기록 (err 가 있으면 실패)
+type recordingSender struct {
+	sent []string
+	err  error
+}
+
+func (r *recordingSender) Send(ctx context.Context, to string, subject string, text string) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.sent = append(r.sent, to)
+	return nil
+}
+
+func TestDecisionMail(t *testing.T) {
+	subject, text := approvedMail("홍길동")
+	if !strings.Contains(subject, "승인") || !strings.HasPrefix(text, "홍길동 님") {
+		t.Fatalf("승인 메일 오류: %q / %q", subject, text)
+	}
+
+	subject, text = rejectedMail("홍길동", "소속 확인 불가")
+	if !strings.Contains(subject, "거절") || !strings.Contains(text, "사유: 소속 확인 불가") {
+		t.Fatalf("거절 메일 오류: %q / %q", subject, text)
+	}
+}
+
+// 승인 / 거절 안내는 계정 메일이라 알림 설정 조회 없이 항상 발송
+// (이메일 알림을 끈 회원도 수신, 발송 실패만 notified=false)
+func TestNotifyIgnoresNotificationPreference(t *testing.T) {
+	member := query.Member{MemberID: 1, Email: "user@example.com"}
+
+	sender := &recordingSender{}
+	service := NewApprovalService(nil, nil, nil, sender)
+	if !service.notify(context.Background(), member, "subject", "text") {
+		t.Fatal("안내 메일 미발송")
+	}
+	if len(sender.sent) != 1 || sender.sent[0] != member.Email {
+		t.Errorf("수신자 불일치: %v", sender.sent)
+	}
+
+	failing := NewApprovalService(nil, nil, nil, &recordingSender{err: errors.New("smtp down")})
+	if failing.notify(context.Background(), member, "subject", "text") {
+		t.Error("발송 실패인데 notified=true")
+	}
+}
@@ -0,0 +1,42 @@
+package approval
+
+import (
+	"study/internal/query"
+	"study/internal/shared/pagination"
+)
+
+// 승인 대기 목록 (cursor, limit, sort, createdAt 필터)
+// - sort: createdAt(기본, 오래된 순) | id | email
+var queueSpec = &pagination.Spec{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	Sorts: map[string]pagination.Field{
+		"createdAt": {Column: query.PendingColumnCreatedAt, Type: pagination.TypeTime},
+		"id":        {Column: query.PendingColumnMemberID, Type: pagination.TypeInt},
+		"email":     {Column: query.PendingColumnEmail, Type: pagination.TypeString},
+	},
+	DefaultSort: "createdAt",
+	Key:         "id",
+	Filters: map[string]pagination.Filter{
+		"createdAt": {
+			Field: pagination.Field{Column: query.PendingColumnCreatedAt, Type: pagination.TypeTime},
+			Ops:   []pagination.Op{pagination.OpGte, pagination.OpLt},
+		},
+		"email": {
+			Field: pagination.Field{Column: query.PendingColumnEmail, Type: pagination.TypeString},
+			Ops:   []pagination.Op{pagination.OpEq, pagination.OpContains},
+		},
+	},
+}
+
+// 대기 목록 → 정렬 키 값 (커서)
+func queueSortKey(s PendingSignupResponse, name string) any {
+	switch name {
+	case "createdAt":
+		return s.CreatedAt
+	case "email":
+		return s.Email
+	default:
+		return s.ID
+	}
+}
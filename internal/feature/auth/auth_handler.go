@@ -11,10 +11,11 @@ import (
 type AuthHandler struct {
 	service       *AuthService
 	cookieService *CookieService
+	dpop          *DPoPVerifier
 }
 
-func NewAuthHandler(service *AuthService, cookieService *CookieService) *AuthHandler {
-	return &AuthHandler{service: service, cookieService: cookieService}
+func NewAuthHandler(service *AuthService, cookieService *CookieService, dpop *DPoPVerifier) *AuthHandler {
+	return &AuthHandler{service: service, cookieService: cookieService, dpop: dpop}
 }
 
 // 회원가입
@@ -31,11 +32,15 @@ func (h *AuthHandler) SignUp(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
 	}
 
-	if err := h.service.Register(ctx, &req); err != nil {
+	signUp, err := h.service.Register(ctx, &req)
+	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "회원가입 실패", nil))
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response.OK("회원가입 성공", nil))
+	if signUp.PendingApproval {
+		return c.Status(fiber.StatusOK).JSON(response.OK("회원가입 완료 (관리자 승인 대기)", signUp))
+	}
+	return c.Status(fiber.StatusOK).JSON(response.OK("회원가입 성공", signUp))
 }
 
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
@@ -51,14 +56,47 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
 	}
 
+	// DPoP proof 가 있으면 발급 토큰을 해당 키에 바인딩
+	jkt, err := h.dpopKey(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "DPoP 검증 실패", nil))
+	}
+	req.DPoPJkt = jkt
+
 	loginResponse, err := h.service.Login(ctx, &req)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "로그인 실패", nil))
 	}
+
+	// 비밀번호 만료: 리프레쉬 쿠키 없이 비밀번호 변경 전용 토큰만 전달
+	if loginResponse.PasswordExpired {
+		// BFF 모드: 비밀번호 변경 토큰도 access 쿠키 + CSRF 토큰으로 전달
+		if h.cookieService.BFF() {
+			csrfToken, err := h.cookieService.IssueCsrfToken(c, false)
+			if err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(response.Error(ErrCsrfTokenInvalid.Error(), "CSRF 토큰 발급 실패", nil))
+			}
+			h.cookieService.SetAccessCookie(c, loginResponse.AccessToken)
+			loginResponse.AccessToken = ""
+			loginResponse.CsrfToken = csrfToken
+		}
+		return c.Status(fiber.StatusForbidden).JSON(response.Error(ErrPasswordExpired.Error(), "비밀번호 변경 필요", loginResponse))
+	}
 	// 쿠키 생성
 	_ = h.cookieService.SetCookie(c, loginResponse.RefreshToken, req.RememberMe)
 	loginResponse.RefreshToken = ""
 
+	// BFF 모드: access 토큰을 HttpOnly 쿠키로 전달하고 CSRF 토큰 발급
+	if h.cookieService.BFF() {
+		csrfToken, err := h.cookieService.IssueCsrfToken(c, req.RememberMe)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(response.Error(ErrCsrfTokenInvalid.Error(), "CSRF 토큰 발급 실패", nil))
+		}
+		h.cookieService.SetAccessCookie(c, loginResponse.AccessToken)
+		loginResponse.AccessToken = ""
+		loginResponse.CsrfToken = csrfToken
+	}
+
 	return c.Status(fiber.StatusOK).JSON(response.OK("로그인 성공", loginResponse))
 }
 
@@ -69,15 +107,169 @@ func (h *AuthHandler) Refresh(c *fiber.Ctx) error {
 	if refreshToken == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(response.Error(ErrCookieNotFound.Error(), "리프레쉬 쿠키 누락", nil))
 	}
-	loginResponse, err := h.service.Refresh(ctx, refreshToken)
+
+	// DPoP 바인딩된 refresh 토큰은 같은 키의 proof 필요
+	jkt, err := h.dpopKey(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "DPoP 검증 실패", nil))
+	}
+
+	loginResponse, err := h.service.Refresh(ctx, refreshToken, jkt)
+	if err == ErrSessionExpired {
+		// 만료된 세션의 쿠키 정리
+		h.cookieService.RemoveSessionCookies(c)
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(err.Error(), "세션 만료", nil))
+	}
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "리프레쉬 토큰으로 로그인 실패", nil))
 	}
 
+	// BFF 모드: 새 access 토큰을 쿠키로 교체
+	if h.cookieService.BFF() {
+		h.cookieService.SetAccessCookie(c, loginResponse.AccessToken)
+		loginResponse.AccessToken = ""
+	}
+
 	return c.Status(fiber.StatusOK).JSON(response.OK("리프레쉬 토큰으로 로그인 성공", loginResponse))
 }
 
 func (h *AuthHandler) Logout(c *fiber.Ctx) error {
-	h.cookieService.RemoveCookie(c)
+	ctx := c.UserContext()
+
+	// 리프레쉬 세션 / 불투명 access 토큰 폐기 (실패해도 쿠키는 삭제)
+	_ = h.service.Logout(ctx, h.cookieService.GetCookie(c), h.accessToken(c))
+
+	h.cookieService.RemoveSessionCookies(c)
 	return c.Status(fiber.StatusOK).JSON(response.OK("로그아웃 성공", nil))
 }
+
+// 로그인 상태 조회 (선택 인증, BFF 모드에서 프론트가 HttpOnly 쿠키 대신 확인)
+func (h *AuthHandler) Status(c *fiber.Ctx) error {
+	resp := &AuthStatusResponse{}
+	if claims := ClaimsFrom(c); claims != nil {
+		resp.Authenticated = true
+		resp.MemberID = claims.MemberID
+		resp.RecentlyAuthenticated = h.service.JwtService.RecentlyAuthenticated(claims)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("로그인 상태 조회 성공", resp))
+}
+
+// 모든 기기 로그아웃
+func (h *AuthHandler) LogoutAll(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	if err := h.service.LogoutAll(ctx, claims.MemberID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(response.Error(errorx.ErrInternal.Error(), "세션 폐기 실패", nil))
+	}
+
+	h.cookieService.RemoveSessionCookies(c)
+	return c.Status(fiber.StatusOK).JSON(response.OK("모든 기기 로그아웃 성공", nil))
+}
+
+// 요청의 access 토큰 (Authorization 헤더 또는 BFF access 쿠키)
+func (h *AuthHandler) accessToken(c *fiber.Ctx) string {
+	authHeader := c.Get(fiber.HeaderAuthorization)
+	if token := ExtractBearer(authHeader); token != "" {
+		return token
+	}
+	if token := ExtractDPoP(authHeader); token != "" {
+		return token
+	}
+	return h.cookieService.GetAccessCookie(c)
+}
+
+// 비밀번호 변경
+func (h *AuthHandler) ChangePassword(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	var req ChangePasswordRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
+	}
+
+	if err := h.service.ChangePassword(ctx, claims.MemberID, &req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "비밀번호 변경 실패", nil))
+	}
+
+	// 세션이 모두 폐기되었으므로 쿠키도 삭제 (재로그인)
+	h.cookieService.RemoveSessionCookies(c)
+	return c.Status(fiber.StatusOK).JSON(response.OK("비밀번호 변경 성공", nil))
+}
+
+// 재인증 (민감 작업 전 비밀번호 재확인)
+func (h *AuthHandler) Reauthenticate(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	var req ReauthenticateRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	if req.Password == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "필수값 누락", nil))
+	}
+
+	// 현재 토큰과 같은 DPoP 키에 바인딩
+	resp, err := h.service.Reauthenticate(ctx, claims.MemberID, &req, claims.BoundKey())
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(err.Error(), "재인증 실패", nil))
+	}
+
+	// BFF 모드: 단기 access 토큰을 쿠키로 교체 (만료 후 refresh 로 일반 토큰 재발급)
+	if h.cookieService.BFF() {
+		h.cookieService.SetAccessCookie(c, resp.AccessToken)
+		resp.AccessToken = ""
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("재인증 성공", resp))
+}
+
+// 토큰 인트로스펙션 (RFC 7662, 리소스 서버 전용)
+// 응답은 RFC 형식을 따르므로 공통 응답 포맷을 사용하지 않음
+func (h *AuthHandler) Introspect(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	token := c.FormValue("token")
+	if token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid_request"})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(h.service.Introspect(ctx, token))
+}
+
+// 토큰 발급 요청의 DPoP proof 검증 후 공개키 thumbprint 반환 (proof 없으면 "")
+// - BFF 모드는 access 토큰이 HttpOnly 쿠키로만 전달되므로 Bearer 허용 여부와 무관하게 proof 없이 발급
+func (h *AuthHandler) dpopKey(c *fiber.Ctx) (string, error) {
+	proof := c.Get("DPoP")
+
+	if !h.dpop.Enabled() || proof == "" {
+		if !h.dpop.AllowBearer() && !h.cookieService.BFF() {
+			return "", ErrDPoPRequired
+		}
+		return "", nil
+	}
+
+	return h.dpop.VerifyProof(proof, c.Method(), c.BaseURL()+c.OriginalURL(), "")
+}
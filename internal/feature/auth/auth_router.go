@@ -19,16 +19,46 @@ func (r *AuthRouter) RegisterRoutes(
 
 	api.Post("/signup", r.handler.SignUp)
 	api.Post("/login", r.handler.Login)
-	api.Post("/refresh", r.handler.Refresh)
-	api.Post("/logout", r.handler.Logout)
 
+	// refresh 쿠키로 인증되는 라우트 (BFF 모드에서 CSRF 검증)
+	csrf := r.handler.cookieService.CsrfProtect()
+	api.Post("/refresh", csrf, r.handler.Refresh)
+	api.Post("/logout", csrf, r.handler.Logout)
+
+}
+
+// 리소스 서버 인증이 필요한 라우트
+func (r *AuthRouter) RegisterIntrospectionRoutes(
+	introspection fiber.Router,
+) {
+	introspection.Post("", r.handler.Introspect)
+}
+
+// 비밀번호 만료 상태에서도 접근 가능한 라우트
+func (r *AuthRouter) RegisterPasswordRoutes(
+	password fiber.Router,
+) {
+	password.Patch("", r.handler.ChangePassword)
+}
+
+// 익명 / 로그인 사용자 공용 라우트 (선택 인증)
+func (r *AuthRouter) RegisterStatusRoutes(
+	status fiber.Router,
+) {
+	status.Get("", r.handler.Status)
 }
 
 func (r *AuthRouter) RegisterAuthRoutes(
 	auth fiber.Router,
 ) {
 	apiAuth := auth.Group("/auth")
-	if apiAuth == nil {
-		return
-	}
+
+	apiAuth.Post("/reauthenticate", r.handler.Reauthenticate)
+}
+
+// 최근 재인증이 필요한 라우트 (세션 관리)
+func (r *AuthRouter) RegisterSessionRoutes(
+	sessions fiber.Router,
+) {
+	sessions.Delete("", r.handler.LogoutAll)
 }
@@ -2,15 +2,20 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
-	"study/internal/feature/member"
+	"study/internal/config"
 	"study/internal/observability"
 	"study/internal/query"
 	"study/internal/shared/mapper"
 	"study/internal/shared/model"
+	"study/internal/shared/profileimage"
 	"study/pkg/log"
 	"study/pkg/util"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -18,26 +23,40 @@ import (
 // AuthService
 // - 인증/회원가입 비즈니스 로직 전용
 type AuthService struct {
-	JwtService *JwtService
-	pool       *pgxpool.Pool
-	queries    *query.Queries
+	JwtService     *JwtService
+	sessions       *SessionService
+	authenticators *AuthenticatorChain
+	hooks          *HookRegistry
+	pool           *pgxpool.Pool
+	queries        *query.Queries
+	passwordPolicy *config.Password
+	signup         *config.Signup
+	profiles       *profileimage.Resolver
+	now            func() time.Time // 토큰 발급과 같은 시계 (JwtService WithClock)
 }
 
 // 생성자
-func NewAuthService(pool *pgxpool.Pool, queries *query.Queries, JwtService *JwtService) *AuthService {
-	return &AuthService{pool: pool, queries: queries, JwtService: JwtService}
+func NewAuthService(pool *pgxpool.Pool, queries *query.Queries, JwtService *JwtService, sessions *SessionService, authenticators *AuthenticatorChain, hooks *HookRegistry, passwordPolicy *config.Password, signup *config.Signup, profiles *profileimage.Resolver) *AuthService {
+	return &AuthService{pool: pool, queries: queries, JwtService: JwtService, sessions: sessions, authenticators: authenticators, hooks: hooks, passwordPolicy: passwordPolicy, signup: signup, profiles: profiles, now: JwtService.now}
 }
 
-// 회원가입
-func (s *AuthService) Register(ctx context.Context, m *SignUpRequest) (err error) {
+// 회원가입 (승인 모드는 READY 로 생성, 관리자 승인 전까지 로그인 불가)
+func (s *AuthService) Register(ctx context.Context, m *SignUpRequest) (resp *SignUpResponse, err error) {
 	ctx, span, start := observability.StartServiceSpan(ctx, "Register")
 	defer observability.EndSpanWithLatency(span, start, 100)
 
+	// 사전 훅 (가입 도메인 제한 등)
+	hook := &HookContext{Event: HookRegister, Email: m.Email, Name: m.Name, Roles: []model.Role{model.RoleUser}}
+	if err = s.hooks.runPre(ctx, hook); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
 	// 트랜젝션 시작
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
 		observability.RecordServiceError(span, err)
-		return err
+		return nil, err
 	}
 	defer tx.Rollback(ctx)
 
@@ -47,14 +66,19 @@ func (s *AuthService) Register(ctx context.Context, m *SignUpRequest) (err error
 	_, err = transaction.FindMemberByEmail(ctx, m.Email)
 	if err == nil {
 		observability.RecordBusinessError(span, ErrEmailAlreadyExists)
-		return ErrEmailAlreadyExists
+		return nil, ErrEmailAlreadyExists
 	}
 
 	// 비밀번호 암호화
 	hashed, err := util.HashString(m.Password)
 	if err != nil {
 		observability.RecordServiceError(span, err)
-		return err
+		return nil, err
+	}
+
+	status := model.StatusActive
+	if s.signup != nil && s.signup.RequiresApproval() {
+		status = model.StatusReady
 	}
 
 	// 회원생성
@@ -62,37 +86,55 @@ func (s *AuthService) Register(ctx context.Context, m *SignUpRequest) (err error
 		Email:    m.Email,
 		Password: hashed,
 		Name:     m.Name,
-		Status:   model.StatusActive,
+		Status:   status,
 	})
 	if err != nil {
 		observability.RecordServiceError(span, err)
-		return err
+		return nil, err
 	}
 
 	// 기본권한 추가
 	err = transaction.InsertMemberRole(ctx, query.InsertMemberRoleParams{
 		MemberID: memberID,
-		Role:     member.RoleUser,
+		Role:     model.RoleUser,
 	})
 	if err != nil {
 		observability.RecordServiceError(span, err)
-		return err
+		return nil, err
+	}
+
+	// 비밀번호 이력 저장
+	err = transaction.InsertPasswordHistory(ctx, query.InsertPasswordHistoryParams{
+		MemberID: memberID,
+		Password: hashed,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
 	}
 
 	span.SetAttributes(
 		attribute.String("auth.type", "register"),
 		attribute.Int64("member.id", memberID),
+		attribute.String("member.status", string(status)),
 	)
 
-	log.InfoCtx(ctx, "회원가입 성공")
+	log.InfoCtx(ctx, "회원가입 성공", log.MapStr("status", string(status)))
 
 	// 커밋
 	if err = tx.Commit(ctx); err != nil {
 		observability.RecordServiceError(span, err)
-		return err
+		return nil, err
 	}
 
-	return nil
+	hook.MemberID = memberID
+	s.hooks.runPost(ctx, hook)
+
+	return &SignUpResponse{
+		ID:              memberID,
+		Status:          status,
+		PendingApproval: status == model.StatusReady,
+	}, nil
 }
 
 // 로그인
@@ -100,18 +142,38 @@ func (s *AuthService) Login(ctx context.Context, req *LoginRequest) (resp *Login
 	ctx, span, start := observability.StartServiceSpan(ctx, "Login")
 	defer observability.EndSpanWithLatency(span, start, 0)
 
-	// 이메일로 회원 조회
-	member, err := s.queries.FindMemberByEmail(ctx, req.Email)
+	// 인증 체인으로 자격 증명 확인 (local, LDAP 등)
+	identity, err := s.authenticators.Authenticate(ctx, req.Email, req.Password)
 	if err != nil {
-		observability.RecordBusinessError(span, ErrInvalidCredential)
-		return nil, ErrInvalidCredential
+		if err == ErrInvalidCredential {
+			observability.RecordBusinessError(span, err)
+		} else {
+			observability.RecordServiceError(span, err)
+		}
+		return nil, err
 	}
 
-	// 비밀번호 비교
-	err = util.VerifyHashString(req.Password, member.Password)
+	// 회원 조회 (외부 인증은 연결 회원 조회 / 자동 생성)
+	member, err := s.resolveMember(ctx, identity)
 	if err != nil {
-		observability.RecordBusinessError(span, ErrInvalidCredential)
-		return nil, ErrInvalidCredential
+		if err == ErrInvalidCredential || err == ErrEmailAlreadyExists {
+			observability.RecordBusinessError(span, err)
+		} else {
+			observability.RecordServiceError(span, err)
+		}
+		return nil, err
+	}
+
+	// 가입 승인 대기 (자격 증명 확인 후에만 알려줌)
+	if member.Status == model.StatusReady {
+		observability.RecordBusinessError(span, ErrPendingApproval)
+		return nil, ErrPendingApproval
+	}
+
+	// 활성 회원만 로그인 허용
+	if member.Status != model.StatusActive {
+		observability.RecordBusinessError(span, ErrMemberDisabled)
+		return nil, ErrMemberDisabled
 	}
 
 	// 권한 조회
@@ -121,8 +183,53 @@ func (s *AuthService) Login(ctx context.Context, req *LoginRequest) (resp *Login
 		return nil, err
 	}
 
+	// 사전 훅 (기능별 로그인 제한)
+	hook := &HookContext{Event: HookLogin, MemberID: member.MemberID, Email: member.Email, Provider: identity.Provider, Roles: roles}
+	if err = s.hooks.runPre(ctx, hook); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	memberResp, err := s.memberResponse(ctx, member, roles)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 비밀번호 만료 시 비밀번호 변경 전용 토큰만 발급 (외부 디렉터리 비밀번호는 제외)
+	if identity.Provider == ProviderLocal && s.isPasswordExpired(member, roles) {
+		accessToken, err := s.JwtService.GeneratePasswordChangeToken(ctx, member.MemberID, req.DPoPJkt)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+
+		observability.RecordBusinessError(span, ErrPasswordExpired)
+		log.InfoCtx(ctx, "비밀번호 만료 회원 로그인", log.MapInt64("memberId", member.MemberID))
+		return &LoginResponse{
+			AccessToken:     accessToken,
+			TokenType:       tokenScheme(req.DPoPJkt),
+			PasswordExpired: true,
+			Member:          memberResp,
+		}, nil
+	}
+
+	// 훅 클레임
+	ext, err := s.hooks.runClaims(ctx, hook)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 리프레쉬 세션 생성
+	sessionID, err := s.sessions.Create(ctx, member.MemberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
 	// 토큰 생성
-	loginResponse, err := s.JwtService.Login(member.MemberID)
+	loginResponse, err := s.JwtService.Login(ctx, member.MemberID, sessionID, req.DPoPJkt, ext)
 	if err != nil {
 		observability.RecordServiceError(span, err)
 		return nil, err
@@ -130,22 +237,21 @@ func (s *AuthService) Login(ctx context.Context, req *LoginRequest) (resp *Login
 
 	span.SetAttributes(
 		attribute.String("auth.type", "login"),
+		attribute.String("auth.provider", identity.Provider),
 		attribute.Int64("member.id", member.MemberID),
 	)
 
-	loginResponse.Member = MemberResponse{
-		ID:      member.MemberID,
-		Email:   member.Email,
-		Profile: mapper.TextPtr(member.Profile),
-		Roles:   roles,
-	}
+	loginResponse.Member = memberResp
+
+	s.hooks.runPost(ctx, hook)
 
 	log.InfoCtx(ctx, "로그인 성공")
 	return loginResponse, nil
 }
 
 // 리프레쉬 토큰으로 로그인 상태 유지
-func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (resp *LoginResponse, err error) {
+// jkt: 요청의 DPoP proof 공개키 thumbprint (없으면 "")
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string, jkt string) (resp *LoginResponse, err error) {
 	ctx, span, start := observability.StartServiceSpan(ctx, "Refresh")
 	defer observability.EndSpanWithLatency(span, start, 30)
 
@@ -166,6 +272,28 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (resp *L
 		}
 	}
 
+	// OAuth 클라이언트에 발급한 refresh 토큰은 /oauth/token 에서만 사용
+	if claims.ClientID != "" {
+		observability.RecordBusinessError(span, ErrTokenTypeWrong)
+		return nil, ErrTokenTypeWrong
+	}
+
+	// DPoP 바인딩된 refresh 토큰은 같은 키의 proof 필요
+	if bound := claims.BoundKey(); bound != "" && bound != jkt {
+		observability.RecordBusinessError(span, ErrDPoPKeyMismatch)
+		return nil, ErrDPoPKeyMismatch
+	}
+
+	// 세션 유휴/절대 만료 확인 및 유휴 만료 연장
+	if err = s.sessions.Touch(ctx, claims.SessionID, claims.MemberID); err != nil {
+		if err == ErrSessionExpired {
+			observability.RecordBusinessError(span, err)
+		} else {
+			observability.RecordServiceError(span, err)
+		}
+		return nil, err
+	}
+
 	// 회원 찾기
 	member, err := s.queries.FindMemberByID(ctx, claims.MemberID)
 	if err != nil {
@@ -173,6 +301,12 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (resp *L
 		return nil, err
 	}
 
+	// 비활성화된 회원은 로그인 유지 불가
+	if member.Status != model.StatusActive {
+		observability.RecordBusinessError(span, ErrMemberDisabled)
+		return nil, ErrMemberDisabled
+	}
+
 	// 권한 조회
 	roles, err := s.queries.GetRolesByMemberID(ctx, member.MemberID)
 	if err != nil {
@@ -180,8 +314,34 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (resp *L
 		return nil, err
 	}
 
-	// 엑세스 토큰 생성
-	accessToken, err := s.JwtService.GenerateAccessToken(member.MemberID)
+	// 비밀번호 만료 시 재로그인 유도
+	if s.isPasswordExpired(member, roles) {
+		observability.RecordBusinessError(span, ErrPasswordExpired)
+		return nil, ErrPasswordExpired
+	}
+
+	// 사전 훅
+	hook := &HookContext{Event: HookRefresh, MemberID: member.MemberID, Email: member.Email, Roles: roles}
+	if err = s.hooks.runPre(ctx, hook); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// 훅 클레임 (재발급 시점 기준으로 다시 계산)
+	ext, err := s.hooks.runClaims(ctx, hook)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 엑세스 토큰 생성 (로그인 시각 유지)
+	accessToken, err := s.JwtService.GenerateAccessTokenAt(ctx, member.MemberID, jkt, claims.AuthTime, ext)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	memberResp, err := s.memberResponse(ctx, member, roles)
 	if err != nil {
 		observability.RecordServiceError(span, err)
 		return nil, err
@@ -192,15 +352,501 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (resp *L
 		attribute.Int64("member.id", member.MemberID),
 	)
 
+	s.hooks.runPost(ctx, hook)
+
 	log.InfoCtx(ctx, "로그인 유지 성공")
 	// 리프레쉬 토큰 제외한 로그인 응답 반환
 	return &LoginResponse{
 		AccessToken: accessToken,
-		Member: MemberResponse{
-			ID:      member.MemberID,
-			Email:   member.Email,
-			Profile: mapper.TextPtr(member.Profile),
-			Roles:   roles,
-		},
+		TokenType:   tokenScheme(jkt),
+		Member:      memberResp,
+	}, nil
+}
+
+// 로그인 응답 회원 정보 (프로필 이미지는 기간 제한 URL)
+func (s *AuthService) memberResponse(ctx context.Context, member query.Member, roles []model.Role) (MemberResponse, error) {
+	image, err := s.profiles.Resolve(ctx, member.MemberID, member.Profile)
+	if err != nil {
+		return MemberResponse{}, err
+	}
+
+	return MemberResponse{
+		ID:           member.MemberID,
+		Email:        member.Email,
+		ProfileImage: image,
+		Roles:        roles,
+	}, nil
+}
+
+// 재인증 (민감 작업 전 자격 증명 재확인 후 auth_time 을 갱신한 단기 토큰 발급)
+// jkt: 현재 토큰에 바인딩된 DPoP 키 (없으면 "")
+func (s *AuthService) Reauthenticate(ctx context.Context, memberID int64, req *ReauthenticateRequest, jkt string) (resp *ReauthenticateResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "Reauthenticate")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	// 회원 찾기
+	member, err := s.queries.FindMemberByID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	if member.Status != model.StatusActive {
+		observability.RecordBusinessError(span, ErrMemberDisabled)
+		return nil, ErrMemberDisabled
+	}
+
+	// 로그인과 같은 인증 체인으로 확인
+	identity, err := s.authenticators.Authenticate(ctx, member.Email, req.Password)
+	if err != nil {
+		if err == ErrInvalidCredential {
+			observability.RecordBusinessError(span, err)
+		} else {
+			observability.RecordServiceError(span, err)
+		}
+		return nil, err
+	}
+
+	// 인증된 계정이 토큰의 회원과 같은지 확인
+	same, err := s.isSameMember(ctx, identity, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	if !same {
+		observability.RecordBusinessError(span, ErrInvalidCredential)
+		return nil, ErrInvalidCredential
+	}
+
+	// 권한 조회
+	roles, err := s.queries.GetRolesByMemberID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 사전 훅 (로그인 제한 규칙 동일 적용)
+	hook := &HookContext{Event: HookReauthenticate, MemberID: memberID, Email: member.Email, Provider: identity.Provider, Roles: roles}
+	if err = s.hooks.runPre(ctx, hook); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// 훅 클레임 (재인증 토큰도 로그인 토큰과 같은 클레임 유지)
+	ext, err := s.hooks.runClaims(ctx, hook)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	accessToken, err := s.JwtService.GenerateElevatedAccessToken(ctx, memberID, jkt, ext)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("auth.type", "reauthenticate"),
+		attribute.String("auth.provider", identity.Provider),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "재인증 성공")
+	return &ReauthenticateResponse{
+		AccessToken: accessToken,
+		TokenType:   tokenScheme(jkt),
+		ExpiresIn:   s.JwtService.ReauthExpiresIn(),
 	}, nil
 }
+
+// access 토큰 인트로스펙션 (RFC 7662)
+// 유효하지 않은 토큰은 이유와 관계없이 active=false 만 반환
+func (s *AuthService) Introspect(ctx context.Context, token string) *IntrospectionResponse {
+	ctx, span, start := observability.StartServiceSpan(ctx, "Introspect")
+	defer observability.EndSpanWithLatency(span, start, 30)
+
+	claims, err := s.JwtService.VerifyAccessToken(ctx, token)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return &IntrospectionResponse{Active: false}
+	}
+
+	span.SetAttributes(
+		attribute.String("auth.type", "introspect"),
+		attribute.Int64("member.id", claims.MemberID),
+	)
+
+	return &IntrospectionResponse{
+		Active:    true,
+		Scope:     string(claims.Scope),
+		TokenType: tokenScheme(claims.BoundKey()),
+		ClientID:  claims.ClientID,
+		Sub:       claims.Subject,
+		Iss:       claims.Issuer,
+		Aud:       claims.Audience,
+		Exp:       numericDate(claims.ExpiresAt),
+		Iat:       numericDate(claims.IssuedAt),
+		Nbf:       numericDate(claims.NotBefore),
+		Jti:       claims.ID,
+		Cnf:       claims.Confirmation,
+	}
+}
+
+// 로그아웃 (리프레쉬 세션 / 불투명 access 토큰 폐기)
+func (s *AuthService) Logout(ctx context.Context, refreshToken string, accessToken string) (err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "Logout")
+	defer observability.EndSpanWithLatency(span, start, 30)
+
+	// 불투명 access 토큰은 즉시 폐기 (JWT 모드는 무시)
+	if err = s.JwtService.RevokeAccessToken(ctx, accessToken); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	// 만료/위조된 토큰은 폐기할 세션이 없음
+	claims, err := s.JwtService.VerifyRefreshToken(refreshToken)
+	if err != nil || claims.SessionID == "" {
+		return nil
+	}
+
+	if err = s.sessions.Revoke(ctx, claims.SessionID); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.String("auth.type", "logout"),
+		attribute.Int64("member.id", claims.MemberID),
+	)
+
+	log.InfoCtx(ctx, "로그아웃 성공")
+	return nil
+}
+
+// 모든 기기 로그아웃 (회원의 리프레쉬 세션 전체 폐기)
+func (s *AuthService) LogoutAll(ctx context.Context, memberID int64) (err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "LogoutAll")
+	defer observability.EndSpanWithLatency(span, start, 30)
+
+	if err = s.sessions.RevokeAll(ctx, memberID); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	if err = s.JwtService.RevokeAccessTokens(ctx, memberID); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.String("auth.type", "logout_all"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "모든 기기 로그아웃 성공", log.MapInt64("memberId", memberID))
+	return nil
+}
+
+// 비밀번호 변경 (성공 시 모든 세션 폐기)
+func (s *AuthService) ChangePassword(ctx context.Context, memberID int64, req *ChangePasswordRequest) (err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ChangePassword")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	// 회원 찾기
+	member, err := transaction.FindMemberByID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	// 현재 비밀번호 확인
+	if err = util.VerifyHashString(req.CurrentPassword, member.Password); err != nil {
+		observability.RecordBusinessError(span, ErrInvalidCredential)
+		return ErrInvalidCredential
+	}
+
+	// 최근 비밀번호 재사용 확인
+	if err = s.checkPasswordHistory(ctx, transaction, member, req.NewPassword); err != nil {
+		if err == ErrPasswordReused {
+			observability.RecordBusinessError(span, err)
+		} else {
+			observability.RecordServiceError(span, err)
+		}
+		return err
+	}
+
+	// 비밀번호 암호화
+	hashed, err := util.HashString(req.NewPassword)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	// 비밀번호 변경
+	err = transaction.UpdateMemberPassword(ctx, query.UpdateMemberPasswordParams{
+		MemberID: memberID,
+		Password: hashed,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	// 비밀번호 이력 저장 및 정리
+	if err = s.savePasswordHistory(ctx, transaction, memberID, hashed); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	// 기존 세션 전체 폐기 (유출된 비밀번호로 만든 세션 차단, 재로그인 필요)
+	if err = s.sessions.WithQueries(transaction).RevokeAll(ctx, memberID); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.String("auth.type", "change_password"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	// 불투명 access 토큰 폐기 (비밀번호는 이미 변경되었으므로 실패는 기록만)
+	if err = s.JwtService.RevokeAccessTokens(ctx, memberID); err != nil {
+		observability.RecordServiceError(span, err)
+		log.ErrorCtx(ctx, "비밀번호 변경 후 access 토큰 폐기 실패", log.MapInt64("memberId", memberID), log.MapErr("error", err))
+	}
+
+	log.InfoCtx(ctx, "비밀번호 변경 성공")
+	return nil
+}
+
+// 인증 결과의 회원 조회
+// - 외부 인증: 연결된 회원 조회 후 매핑 권한 동기화, 연결이 없으면 자동 생성
+// - 같은 이메일의 기존 회원은 자동 연결하지 않음 (계정 탈취 방지)
+func (s *AuthService) resolveMember(ctx context.Context, identity *Identity) (query.Member, error) {
+	if identity.MemberID != 0 {
+		return s.queries.FindMemberByID(ctx, identity.MemberID)
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return query.Member{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	memberID, err := transaction.FindMemberIdentity(ctx, query.FindMemberIdentityParams{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		memberID, err = s.provisionMember(ctx, transaction, identity)
+	}
+	if err != nil {
+		return query.Member{}, err
+	}
+
+	// 매핑 대상 권한을 디렉터리 기준으로 동기화
+	if err = syncManagedRoles(ctx, transaction, memberID, identity); err != nil {
+		return query.Member{}, err
+	}
+
+	m, err := transaction.FindMemberByID(ctx, memberID)
+	if err != nil {
+		return query.Member{}, err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		return query.Member{}, err
+	}
+
+	return m, nil
+}
+
+// 인증 결과가 해당 회원인지 확인 (외부 인증은 연결 정보로 확인)
+func (s *AuthService) isSameMember(ctx context.Context, identity *Identity, memberID int64) (bool, error) {
+	if identity.MemberID != 0 {
+		return identity.MemberID == memberID, nil
+	}
+
+	linked, err := s.queries.FindMemberIdentity(ctx, query.FindMemberIdentityParams{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return linked == memberID, nil
+}
+
+// 외부 인증 회원 자동 생성 (JIT)
+func (s *AuthService) provisionMember(ctx context.Context, q *query.Queries, identity *Identity) (int64, error) {
+	if !identity.Provision {
+		return 0, ErrInvalidCredential
+	}
+
+	// 같은 이메일의 회원이 있으면 생성하지 않음
+	if _, err := q.FindMemberByEmail(ctx, identity.Email); err == nil {
+		return 0, ErrEmailAlreadyExists
+	}
+
+	// 로컬 로그인 불가한 임의 비밀번호
+	password, err := util.RandomString(32)
+	if err != nil {
+		return 0, err
+	}
+	hashed, err := util.HashString(password)
+	if err != nil {
+		return 0, err
+	}
+
+	memberID, err := q.CreateMember(ctx, query.CreateMemberParams{
+		Email:    identity.Email,
+		Password: hashed,
+		Name:     identity.Name,
+		Status:   model.StatusActive,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// 기본권한 추가
+	err = q.InsertMemberRole(ctx, query.InsertMemberRoleParams{
+		MemberID: memberID,
+		Role:     model.RoleUser,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.InsertPasswordHistory(ctx, query.InsertPasswordHistoryParams{
+		MemberID: memberID,
+		Password: hashed,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.CreateMemberIdentity(ctx, query.CreateMemberIdentityParams{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		MemberID: memberID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.InfoCtx(ctx, "외부 인증 회원 생성", log.MapStr("provider", identity.Provider), log.MapInt64("memberId", memberID))
+	return memberID, nil
+}
+
+// 매핑 대상 권한 부여/회수 (매핑되지 않은 권한은 유지)
+func syncManagedRoles(ctx context.Context, q *query.Queries, memberID int64, identity *Identity) error {
+	for _, role := range identity.ManagedRoles {
+		var err error
+		if slices.Contains(identity.Roles, role) {
+			err = q.InsertMemberRole(ctx, query.InsertMemberRoleParams{MemberID: memberID, Role: role})
+		} else {
+			err = q.DeleteMemberRole(ctx, query.DeleteMemberRoleParams{MemberID: memberID, Role: role})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 비밀번호 만료 여부 (정책 대상 권한 + 변경 주기 초과)
+func (s *AuthService) isPasswordExpired(m query.Member, roles []model.Role) bool {
+	if s.passwordPolicy == nil || s.passwordPolicy.ExpireDays <= 0 {
+		return false
+	}
+
+	// 대상 권한이 지정된 경우 해당 권한 보유자만 검사
+	if len(s.passwordPolicy.ExpireRoles) > 0 {
+		target := slices.ContainsFunc(roles, func(r model.Role) bool {
+			return slices.Contains(s.passwordPolicy.ExpireRoles, string(r))
+		})
+		if !target {
+			return false
+		}
+	}
+
+	changedAt := mapper.TimeValue(m.PasswordChangedAt)
+	return s.now().After(changedAt.AddDate(0, 0, s.passwordPolicy.ExpireDays))
+}
+
+// 최근 N개 비밀번호(현재 비밀번호 포함)와 일치하면 ErrPasswordReused
+// 비밀번호 변경/재설정 시 공통으로 사용
+func (s *AuthService) checkPasswordHistory(ctx context.Context, q *query.Queries, m query.Member, password string) error {
+	if util.VerifyHashString(password, m.Password) == nil {
+		return ErrPasswordReused
+	}
+
+	if s.passwordPolicy == nil || s.passwordPolicy.HistoryCount <= 0 {
+		return nil
+	}
+
+	histories, err := q.GetRecentPasswordHistories(ctx, query.GetRecentPasswordHistoriesParams{
+		MemberID: m.MemberID,
+		Limit:    int32(s.passwordPolicy.HistoryCount),
+	})
+	if err != nil {
+		return err
+	}
+
+	if matchesAnyPassword(password, histories) {
+		return ErrPasswordReused
+	}
+
+	return nil
+}
+
+// 해시 목록 중 일치하는 비밀번호 여부
+func matchesAnyPassword(password string, hashes []string) bool {
+	return slices.ContainsFunc(hashes, func(hashed string) bool {
+		return util.VerifyHashString(password, hashed) == nil
+	})
+}
+
+// 비밀번호 이력 저장 후 보관 개수 초과분 삭제
+func (s *AuthService) savePasswordHistory(ctx context.Context, q *query.Queries, memberID int64, hashed string) error {
+	err := q.InsertPasswordHistory(ctx, query.InsertPasswordHistoryParams{
+		MemberID: memberID,
+		Password: hashed,
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.passwordPolicy == nil || s.passwordPolicy.HistoryCount <= 0 {
+		return nil
+	}
+
+	return q.DeleteOldPasswordHistories(ctx, query.DeleteOldPasswordHistoriesParams{
+		MemberID: memberID,
+		Limit:    int32(s.passwordPolicy.HistoryCount),
+	})
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+
+	"study/internal/config"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/pkg/log"
+	"study/pkg/util"
+)
+
+// 인증 제공자 이름 (member_identities.provider)
+const (
+	ProviderLocal = "local"
+	ProviderLDAP  = "ldap"
+)
+
+// 자격 증명 확인 결과
+type Identity struct {
+	Provider string
+	Subject  string // 외부 디렉터리 식별자 (local 은 "")
+	MemberID int64  // local 인증 시 확정된 회원 (외부 인증은 0)
+	Email    string
+	Name     string
+
+	// 디렉터리 그룹에서 매핑된 권한 / 동기화 대상 권한 전체
+	Roles        []model.Role
+	ManagedRoles []model.Role
+
+	// 연결된 회원이 없으면 자동 생성
+	Provision bool
+}
+
+// 자격 증명 확인 (local 비밀번호, LDAP bind 등)
+// - 해당 제공자에 없는 사용자 / 비밀번호 불일치는 ErrInvalidCredential
+type Authenticator interface {
+	Name() string
+	Authenticate(ctx context.Context, email string, password string) (*Identity, error)
+}
+
+// 설정 순서대로 시도하여 첫 성공 결과 사용
+type AuthenticatorChain struct {
+	authenticators []Authenticator
+}
+
+func NewAuthenticatorChain(authenticators ...Authenticator) *AuthenticatorChain {
+	return &AuthenticatorChain{authenticators: authenticators}
+}
+
+// 설정 기반 체인 생성 (미설정 시 local 만 사용)
+func NewAuthenticatorChainFromConfig(cfg *config.Authenticator, queries *query.Queries) *AuthenticatorChain {
+	names := cfg.Chain
+	if len(names) == 0 {
+		names = []string{ProviderLocal}
+	}
+
+	authenticators := make([]Authenticator, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case ProviderLocal:
+			authenticators = append(authenticators, NewLocalAuthenticator(queries))
+		case ProviderLDAP:
+			authenticators = append(authenticators, NewLDAPAuthenticator(&cfg.LDAP))
+		}
+	}
+
+	return NewAuthenticatorChain(authenticators...)
+}
+
+// 체인 인증
+// - 자격 증명 거부는 다음 제공자로 진행
+// - 제공자 장애는 기록 후 다음 제공자로 진행, 모두 실패하면 ErrAuthenticatorUnavailable
+func (c *AuthenticatorChain) Authenticate(ctx context.Context, email string, password string) (*Identity, error) {
+	unavailable := false
+
+	for _, authenticator := range c.authenticators {
+		identity, err := authenticator.Authenticate(ctx, email, password)
+		if err == nil {
+			return identity, nil
+		}
+		if err == ErrInvalidCredential {
+			continue
+		}
+
+		unavailable = true
+		log.WarnCtx(ctx, "인증 제공자 오류", log.MapStr("provider", authenticator.Name()), log.MapErr("error", err))
+	}
+
+	if unavailable {
+		return nil, ErrAuthenticatorUnavailable
+	}
+	return nil, ErrInvalidCredential
+}
+
+// 회원 테이블 비밀번호 인증
+type LocalAuthenticator struct {
+	queries *query.Queries
+}
+
+func NewLocalAuthenticator(queries *query.Queries) *LocalAuthenticator {
+	return &LocalAuthenticator{queries: queries}
+}
+
+func (a *LocalAuthenticator) Name() string {
+	return ProviderLocal
+}
+
+func (a *LocalAuthenticator) Authenticate(ctx context.Context, email string, password string) (*Identity, error) {
+	// 이메일로 회원 조회
+	m, err := a.queries.FindMemberByEmail(ctx, email)
+	if err != nil {
+		return nil, ErrInvalidCredential
+	}
+
+	// 비밀번호 비교
+	if err = util.VerifyHashString(password, m.Password); err != nil {
+		return nil, ErrInvalidCredential
+	}
+
+	return &Identity{
+		Provider: ProviderLocal,
+		MemberID: m.MemberID,
+		Email:    m.Email,
+		Name:     m.Name,
+	}, nil
+}
@@ -1,41 +1,106 @@
 package auth
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
 	"study/internal/config"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// 쿠키 세션 모드
+const (
+	CookieModeToken = "token" // access 토큰은 응답 본문, refresh 토큰만 쿠키
+	CookieModeBFF   = "bff"   // access 토큰도 HttpOnly 쿠키 + CSRF 토큰
+)
+
+// 쿠키 값 보호 방식
+const (
+	CookieSealNone    = "none"
+	CookieSealSign    = "sign"    // HMAC-SHA256 서명 (값 노출, 변조 방지)
+	CookieSealEncrypt = "encrypt" // AES-GCM 암호화 (값 비노출, 변조 방지)
+)
+
+// 쿠키 이름 접두사 (브라우저가 속성 조건을 강제)
+var cookiePrefixes = map[string]string{
+	"host":   "__Host-",
+	"secure": "__Secure-",
+}
+
 type CookieService struct {
-	Name     string
-	Path     string
-	HttpOnly bool
-	Secure   bool
-	SameSite string
-	MaxAge   int
+	Name        string
+	Path        string
+	Domain      string
+	HttpOnly    bool
+	Secure      bool
+	SameSite    string
+	Partitioned bool
+	MaxAge      int
+	Mode        string
+	AccessName  string
+	CsrfName    string
+	CsrfHeader  string
+
+	seal    string
+	signKey []byte
+	aead    cipher.AEAD
 }
 
-func NewCookieService(cfg *config.Cookie) *CookieService {
-	return &CookieService{
-		Name:     cfg.Name,
-		Path:     cfg.Path,
-		HttpOnly: cfg.HttpOnly,
-		Secure:   cfg.Secure,
-		SameSite: cfg.SameSite,
-		MaxAge:   cfg.MaxAge,
+// 설정은 config.Cookie.Validate 로 검증된 값을 전제로 함
+func NewCookieService(cfg *config.Cookie) (*CookieService, error) {
+	prefix := cookiePrefixes[cfg.Prefix]
+
+	s := &CookieService{
+		Name:        prefix + cfg.Name,
+		Path:        cfg.Path,
+		Domain:      cfg.Domain,
+		HttpOnly:    cfg.HttpOnly,
+		Secure:      cfg.Secure,
+		SameSite:    cfg.SameSite,
+		Partitioned: cfg.Partitioned,
+		MaxAge:      cfg.MaxAge,
+		Mode:        cfg.Mode,
+		AccessName:  prefix + cfg.AccessName,
+		CsrfName:    prefix + cfg.CsrfName,
+		CsrfHeader:  cfg.CsrfHeader,
+		seal:        cfg.Seal,
+	}
+
+	switch cfg.Seal {
+	case CookieSealSign:
+		s.signKey = cfg.Secret
+	case CookieSealEncrypt:
+		key := sha256.Sum256(cfg.Secret)
+		block, err := aes.NewCipher(key[:])
+		if err != nil {
+			return nil, err
+		}
+		if s.aead, err = cipher.NewGCM(block); err != nil {
+			return nil, err
+		}
 	}
+
+	return s, nil
+}
+
+// BFF 모드 여부 (access 토큰 쿠키 + CSRF 보호)
+func (s *CookieService) BFF() bool {
+	return s.Mode == CookieModeBFF
 }
 
 // 쿠키 생성
 func (s *CookieService) SetCookie(c *fiber.Ctx, refreshToken string, rememberMe bool) error {
 	cookie := &fiber.Cookie{
 		Name:     s.Name,
-		Value:    refreshToken,
+		Value:    s.encode(s.Name, refreshToken),
 		Path:     s.Path,
 		HTTPOnly: s.HttpOnly,
-		Secure:   s.Secure,
-		SameSite: s.SameSite,
 	}
 
 	if rememberMe {
@@ -45,20 +110,18 @@ func (s *CookieService) SetCookie(c *fiber.Ctx, refreshToken string, rememberMe
 		cookie.Expires = time.Time{}
 	}
 
-	c.Cookie(cookie)
+	s.write(c, cookie)
 
 	return c.SendStatus(fiber.StatusOK)
 }
 
 // 쿠키 삭제
 func (s *CookieService) RemoveCookie(c *fiber.Ctx) error {
-	c.Cookie(&fiber.Cookie{
+	s.write(c, &fiber.Cookie{
 		Name:     s.Name,
 		Value:    "",
 		Path:     s.Path,
 		HTTPOnly: s.HttpOnly,
-		Secure:   s.Secure,
-		SameSite: s.SameSite,
 		MaxAge:   -1,
 		Expires:  time.Unix(0, 0),
 	})
@@ -66,7 +129,116 @@ func (s *CookieService) RemoveCookie(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusOK)
 }
 
-// 쿠키 조회
+// refresh / access / CSRF 쿠키 삭제 (로그아웃, 세션 만료)
+func (s *CookieService) RemoveSessionCookies(c *fiber.Ctx) {
+	s.RemoveCookie(c)
+	if s.BFF() {
+		s.RemoveAccessCookie(c)
+		s.RemoveCsrfCookie(c)
+	}
+}
+
+// 쿠키 조회 (서명/복호화 실패 시 "")
 func (s *CookieService) GetCookie(c *fiber.Ctx) string {
-	return c.Cookies(s.Name)
+	return s.decode(s.Name, c.Cookies(s.Name))
+}
+
+// access 토큰 쿠키 생성 (BFF 모드, 세션 쿠키: 만료는 토큰 자체로 판단)
+func (s *CookieService) SetAccessCookie(c *fiber.Ctx, accessToken string) {
+	s.write(c, &fiber.Cookie{
+		Name:     s.AccessName,
+		Value:    s.encode(s.AccessName, accessToken),
+		Path:     "/",
+		HTTPOnly: true,
+	})
+}
+
+// access 토큰 쿠키 삭제
+func (s *CookieService) RemoveAccessCookie(c *fiber.Ctx) {
+	s.write(c, &fiber.Cookie{
+		Name:     s.AccessName,
+		Value:    "",
+		Path:     "/",
+		HTTPOnly: true,
+		MaxAge:   -1,
+		Expires:  time.Unix(0, 0),
+	})
+}
+
+// access 토큰 쿠키 조회 (BFF 모드가 아니면 항상 "")
+func (s *CookieService) GetAccessCookie(c *fiber.Ctx) string {
+	if !s.BFF() {
+		return ""
+	}
+	return s.decode(s.AccessName, c.Cookies(s.AccessName))
+}
+
+// 공통 속성(Domain, Secure, SameSite, Partitioned) 적용 후 Set-Cookie 작성
+func (s *CookieService) write(c *fiber.Ctx, cookie *fiber.Cookie) {
+	cookie.Domain = s.Domain
+	cookie.Secure = s.Secure
+	cookie.SameSite = s.SameSite
+	c.Cookie(cookie)
+
+	// fasthttp 가 Partitioned 속성을 지원하지 않아 직렬화된 헤더에 직접 추가
+	if s.Partitioned {
+		header := &c.Response().Header
+		raw := string(header.PeekCookie(cookie.Name))
+		header.DelCookie(cookie.Name)
+		header.Add(fiber.HeaderSetCookie, raw+"; Partitioned")
+	}
+}
+
+// 쿠키 값 보호 (서명 / 암호화, 쿠키 이름을 함께 묶어 다른 쿠키로의 재사용 방지)
+func (s *CookieService) encode(name string, value string) string {
+	switch s.seal {
+	case CookieSealSign:
+		return value + "." + base64.RawURLEncoding.EncodeToString(s.mac(name, value))
+	case CookieSealEncrypt:
+		nonce := make([]byte, s.aead.NonceSize())
+		_, _ = rand.Read(nonce)
+		sealed := s.aead.Seal(nonce, nonce, []byte(value), []byte(name))
+		return base64.RawURLEncoding.EncodeToString(sealed)
+	}
+	return value
+}
+
+// 쿠키 값 검증 / 복호화 (실패 시 "")
+func (s *CookieService) decode(name string, raw string) string {
+	if raw == "" {
+		return ""
+	}
+
+	switch s.seal {
+	case CookieSealSign:
+		i := strings.LastIndexByte(raw, '.')
+		if i < 0 {
+			return ""
+		}
+		value := raw[:i]
+		sig, err := base64.RawURLEncoding.DecodeString(raw[i+1:])
+		if err != nil || !hmac.Equal(sig, s.mac(name, value)) {
+			return ""
+		}
+		return value
+
+	case CookieSealEncrypt:
+		sealed, err := base64.RawURLEncoding.DecodeString(raw)
+		size := s.aead.NonceSize()
+		if err != nil || len(sealed) < size {
+			return ""
+		}
+		value, err := s.aead.Open(nil, sealed[:size], sealed[size:], []byte(name))
+		if err != nil {
+			return ""
+		}
+		return string(value)
+	}
+	return raw
+}
+
+func (s *CookieService) mac(name string, value string) []byte {
+	h := hmac.New(sha256.New, s.signKey)
+	h.Write([]byte(name + "=" + value))
+	return h.Sum(nil)
 }
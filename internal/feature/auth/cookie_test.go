@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"strings"
+	"study/internal/config"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 테스트용 쿠키 설정
+func testCookieConfig(seal string) *config.Cookie {
+	return &config.Cookie{
+		Name:        "SH_REFRESH",
+		Path:        "/",
+		HttpOnly:    true,
+		Secure:      true,
+		SameSite:    "None",
+		Partitioned: true,
+		Prefix:      "host",
+		Seal:        seal,
+		Secret:      []byte("COOKIE_SECRET_KEY_FOR_TEST_32BYTES"),
+		MaxAge:      14,
+		Mode:        CookieModeBFF,
+		AccessName:  "SH_ACCESS",
+		CsrfName:    "XSRF-TOKEN",
+		CsrfHeader:  "X-CSRF-Token",
+	}
+}
+
+func TestCookieSeal(t *testing.T) {
+	for _, seal := range []string{CookieSealSign, CookieSealEncrypt} {
+		t.Run(seal, func(t *testing.T) {
+			s, err := NewCookieService(testCookieConfig(seal))
+			if err != nil {
+				t.Fatalf("CookieService 생성 실패: %v", err)
+			}
+
+			raw := s.encode(s.Name, "refresh-token")
+			if value := s.decode(s.Name, raw); value != "refresh-token" {
+				t.Errorf("쿠키 값 복원 실패: %q", value)
+			}
+
+			// 변조된 값 (마지막 문자는 base64 패딩 비트만 바뀔 수 있어 첫 문자 변경)
+			first := "A"
+			if strings.HasPrefix(raw, "A") {
+				first = "B"
+			}
+			tampered := first + raw[1:]
+			if value := s.decode(s.Name, tampered); value != "" {
+				t.Errorf("변조된 쿠키 허용됨: %q", value)
+			}
+
+			// 다른 쿠키 이름으로 재사용
+			if value := s.decode(s.AccessName, raw); value != "" {
+				t.Errorf("다른 쿠키의 값 허용됨: %q", value)
+			}
+		})
+	}
+}
+
+func TestCookieAttributes(t *testing.T) {
+	s, err := NewCookieService(testCookieConfig(CookieSealNone))
+	if err != nil {
+		t.Fatalf("CookieService 생성 실패: %v", err)
+	}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return s.SetCookie(c, "refresh-token", true)
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+
+	header := resp.Header.Get(fiber.HeaderSetCookie)
+	for _, attr := range []string{"__Host-SH_REFRESH=refresh-token", "path=/", "secure", "SameSite=None", "Partitioned"} {
+		if !strings.Contains(header, attr) {
+			t.Errorf("Set-Cookie 에 %q 누락: %s", attr, header)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"study/pkg/response"
+	"study/pkg/util"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CSRF 토큰 (double-submit cookie)
+// - JS 가 읽을 수 있는 쿠키로 발급하고, 상태 변경 요청 시 같은 값을 헤더로 전송
+// - 쿠키로 인증되는 요청(BFF access 쿠키, refresh 쿠키)에만 적용
+
+// CSRF 토큰 발급 (refresh 쿠키와 같은 수명)
+func (s *CookieService) IssueCsrfToken(c *fiber.Ctx, rememberMe bool) (string, error) {
+	token, err := util.RandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	// 클라이언트는 쿠키 값을 그대로 헤더로 전송하므로 보호된 값을 토큰으로 사용
+	token = s.encode(s.CsrfName, token)
+
+	cookie := &fiber.Cookie{
+		Name:     s.CsrfName,
+		Value:    token,
+		Path:     "/",
+		HTTPOnly: false,
+	}
+	if rememberMe {
+		cookie.MaxAge = s.MaxAge * 60 * 60 * 24
+	}
+
+	s.write(c, cookie)
+	return token, nil
+}
+
+// 유효한 CSRF 쿠키가 있으면 그대로 사용, 없으면 새로 발급 (HTML 폼용)
+func (s *CookieService) CsrfToken(c *fiber.Ctx) (string, error) {
+	if token := c.Cookies(s.CsrfName); s.decode(s.CsrfName, token) != "" {
+		return token, nil
+	}
+	return s.IssueCsrfToken(c, false)
+}
+
+// CSRF 쿠키 삭제
+func (s *CookieService) RemoveCsrfCookie(c *fiber.Ctx) {
+	s.write(c, &fiber.Cookie{
+		Name:    s.CsrfName,
+		Value:   "",
+		Path:    "/",
+		MaxAge:  -1,
+		Expires: time.Unix(0, 0),
+	})
+}
+
+// 헤더와 쿠키의 CSRF 토큰 일치 여부
+func (s *CookieService) VerifyCsrf(c *fiber.Ctx) bool {
+	return s.VerifyCsrfValue(c, c.Get(s.CsrfHeader))
+}
+
+// 전달된 값(헤더 / 폼 필드)과 쿠키의 CSRF 토큰 일치 여부
+func (s *CookieService) VerifyCsrfValue(c *fiber.Ctx, token string) bool {
+	cookie := c.Cookies(s.CsrfName)
+	if cookie == "" || token == "" {
+		return false
+	}
+
+	// 서명/암호화 사용 시 서버가 발급한 값인지 확인 (하위 도메인의 쿠키 주입 방지)
+	if s.decode(s.CsrfName, cookie) == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(cookie), []byte(token)) == 1
+}
+
+// 상태 변경 요청(POST/PUT/PATCH/DELETE) 여부
+func IsStateChanging(c *fiber.Ctx) bool {
+	switch c.Method() {
+	case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions, fiber.MethodTrace:
+		return false
+	}
+	return true
+}
+
+// 쿠키 인증 라우트용 CSRF 검증 핸들러 (BFF 모드에서만 동작)
+func (s *CookieService) CsrfProtect() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !s.BFF() || !IsStateChanging(c) {
+			return c.Next()
+		}
+
+		if !s.VerifyCsrf(c) {
+			return c.Status(fiber.StatusForbidden).JSON(response.Error(ErrCsrfTokenInvalid.Error(), "CSRF 토큰 검증 실패", nil))
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,330 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"study/internal/config"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DPoP 토큰 타입 (Authorization 스킴 / 응답 tokenType)
+const (
+	TokenSchemeBearer = "Bearer"
+	TokenSchemeDPoP   = "DPoP"
+)
+
+// DPoP proof 에 허용하는 비대칭 서명 알고리즘
+var dpopAlgorithms = []string{"ES256", "ES384", "ES512", "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "EdDSA"}
+
+// 토큰 확인 클레임 (RFC 9449 cnf.jkt)
+type Confirmation struct {
+	JKT string `json:"jkt"`
+}
+
+// DPoP proof JWT Payload
+type dpopClaims struct {
+	HTM string `json:"htm"`
+	HTU string `json:"htu"`
+	ATH string `json:"ath,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// DPoP proof 검증 (RFC 9449)
+// - jti 재사용 방지 캐시는 인스턴스 메모리에 보관 (다중 인스턴스 시 공유 저장소 필요)
+type DPoPVerifier struct {
+	enabled     bool
+	allowBearer bool
+	iatWindow   time.Duration
+	now         func() time.Time
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	lastPurge time.Time
+}
+
+// DPoPVerifier 생성 옵션
+type DPoPOption func(*DPoPVerifier)
+
+// 현재 시각 함수 주입
+func WithDPoPClock(now func() time.Time) DPoPOption {
+	return func(v *DPoPVerifier) {
+		v.now = now
+	}
+}
+
+func NewDPoPVerifier(cfg *config.DPoP, opts ...DPoPOption) *DPoPVerifier {
+	v := &DPoPVerifier{
+		enabled:     cfg.Enabled,
+		allowBearer: cfg.AllowBearer || !cfg.Enabled,
+		iatWindow:   time.Duration(cfg.IatWindowSec) * time.Second,
+		now:         time.Now,
+		seen:        make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// DPoP 사용 여부
+func (v *DPoPVerifier) Enabled() bool {
+	return v.enabled
+}
+
+// Bearer 스킴 허용 여부 (DPoP 미사용 시 항상 허용)
+func (v *DPoPVerifier) AllowBearer() bool {
+	return v.allowBearer
+}
+
+// 지원 proof 서명 알고리즘 (WWW-Authenticate algs 속성)
+func (v *DPoPVerifier) Algorithms() []string {
+	return dpopAlgorithms
+}
+
+// proof 검증 후 공개키 thumbprint(jkt) 반환
+// accessToken 이 비어있으면 ath 검증 생략 (토큰 발급 요청)
+func (v *DPoPVerifier) VerifyProof(proof string, method string, requestURL string, accessToken string) (string, error) {
+	if !v.enabled || proof == "" {
+		return "", ErrDPoPProofInvalid
+	}
+
+	var jwk *dpopJWK
+
+	token, err := jwt.ParseWithClaims(
+		proof,
+		&dpopClaims{},
+		func(token *jwt.Token) (any, error) {
+			// typ 검증
+			if typ, _ := token.Header["typ"].(string); typ != "dpop+jwt" {
+				return nil, ErrDPoPProofInvalid
+			}
+
+			// 헤더의 공개키 추출
+			key, err := parseDPoPJWK(token.Header["jwk"])
+			if err != nil {
+				return nil, err
+			}
+			jwk = key
+
+			return key.publicKey()
+		},
+		jwt.WithValidMethods(dpopAlgorithms),
+		jwt.WithTimeFunc(v.now),
+	)
+	if err != nil || !token.Valid {
+		return "", ErrDPoPProofInvalid
+	}
+
+	claims, ok := token.Claims.(*dpopClaims)
+	if !ok || claims.ID == "" || claims.IssuedAt == nil {
+		return "", ErrDPoPProofInvalid
+	}
+
+	// htm / htu 검증
+	if !strings.EqualFold(claims.HTM, method) || !sameHTU(claims.HTU, requestURL) {
+		return "", ErrDPoPProofInvalid
+	}
+
+	// iat 허용 범위 검증
+	now := v.now()
+	iat := claims.IssuedAt.Time
+	if iat.Before(now.Add(-v.iatWindow)) || iat.After(now.Add(v.iatWindow)) {
+		return "", ErrDPoPProofInvalid
+	}
+
+	// access 토큰 해시 검증
+	if accessToken != "" && claims.ATH != AccessTokenHash(accessToken) {
+		return "", ErrDPoPProofInvalid
+	}
+
+	jkt, err := jwk.thumbprint()
+	if err != nil {
+		return "", ErrDPoPProofInvalid
+	}
+
+	// jti 재사용 검증 (키별로 구분)
+	if !v.markSeen(jkt+":"+claims.ID, now) {
+		return "", ErrDPoPProofReplayed
+	}
+
+	return jkt, nil
+}
+
+// ath 계산 (access 토큰 SHA-256 의 base64url)
+func AccessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// 처음 본 jti 면 기록 후 true, 재사용이면 false
+func (v *DPoPVerifier) markSeen(key string, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// 허용 범위를 벗어난 항목 정리 (iat 허용 범위마다 한 번)
+	if now.Sub(v.lastPurge) > v.iatWindow {
+		for k, expireAt := range v.seen {
+			if now.After(expireAt) {
+				delete(v.seen, k)
+			}
+		}
+		v.lastPurge = now
+	}
+
+	if _, ok := v.seen[key]; ok {
+		return false
+	}
+
+	v.seen[key] = now.Add(2 * v.iatWindow)
+	return true
+}
+
+// htu 비교 (scheme, host, path 만 비교 / query, fragment 제외)
+func sameHTU(htu string, requestURL string) bool {
+	a, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	b, err := url.Parse(requestURL)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(a.Scheme, b.Scheme) &&
+		strings.EqualFold(normalizeHost(a), normalizeHost(b)) &&
+		a.EscapedPath() == b.EscapedPath()
+}
+
+// 기본 포트 제거
+func normalizeHost(u *url.URL) string {
+	port := u.Port()
+	if (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		return u.Hostname()
+	}
+	return u.Host
+}
+
+// proof 헤더의 공개키 (JWK)
+type dpopJWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	D   string `json:"d,omitempty"`
+}
+
+func parseDPoPJWK(raw any) (*dpopJWK, error) {
+	if raw == nil {
+		return nil, ErrDPoPProofInvalid
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, ErrDPoPProofInvalid
+	}
+
+	var key dpopJWK
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, ErrDPoPProofInvalid
+	}
+
+	// 개인키 포함 금지
+	if key.D != "" {
+		return nil, ErrDPoPProofInvalid
+	}
+
+	return &key, nil
+}
+
+// JWK → 공개키
+func (k *dpopJWK) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, ErrDPoPProofInvalid
+		}
+
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		size := (curve.Params().BitSize + 7) / 8
+		if errX != nil || errY != nil || len(x) != size || len(y) != size {
+			return nil, ErrDPoPProofInvalid
+		}
+
+		point := append([]byte{0x04}, append(x, y...)...)
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+		if err != nil {
+			return nil, ErrDPoPProofInvalid
+		}
+		return key, nil
+
+	case "RSA":
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrDPoPProofInvalid
+		}
+
+		key := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		if key.N.BitLen() < 2048 {
+			return nil, ErrDPoPProofInvalid
+		}
+		return key, nil
+
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if k.Crv != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, ErrDPoPProofInvalid
+		}
+		return ed25519.PublicKey(x), nil
+	}
+
+	return nil, ErrDPoPProofInvalid
+}
+
+// JWK thumbprint (RFC 7638, SHA-256)
+func (k *dpopJWK) thumbprint() (string, error) {
+	var canonical string
+
+	switch k.Kty {
+	case "EC":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":"EC","x":%q,"y":%q}`, k.Crv, k.X, k.Y)
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, k.E, k.N)
+	case "OKP":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":"OKP","x":%q}`, k.Crv, k.X)
+	default:
+		return "", ErrDPoPProofInvalid
+	}
+
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
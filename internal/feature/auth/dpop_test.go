@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"study/internal/config"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 테스트용 DPoP proof 생성
+func newDPoPProof(t *testing.T, key *ecdsa.PrivateKey, jti string, htm string, htu string, iat time.Time, ath string) string {
+	t.Helper()
+
+	size := (key.Curve.Params().BitSize + 7) / 8
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, dpopClaims{
+		HTM: htm,
+		HTU: htu,
+		ATH: ath,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       jti,
+			IssuedAt: jwt.NewNumericDate(iat),
+		},
+	})
+	token.Header["typ"] = "dpop+jwt"
+	token.Header["jwk"] = map[string]string{
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(key.PublicKey.X.FillBytes(make([]byte, size))),
+		"y":   base64.RawURLEncoding.EncodeToString(key.PublicKey.Y.FillBytes(make([]byte, size))),
+	}
+
+	proof, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("DPoP proof 생성 실패: %v", err)
+	}
+	return proof
+}
+
+func TestVerifyDPoPProof(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	verifier := NewDPoPVerifier(
+		&config.DPoP{Enabled: true, IatWindowSec: 60},
+		WithDPoPClock(func() time.Time { return now }),
+	)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("키 생성 실패: %v", err)
+	}
+
+	const htu = "https://api.example.com/api/v1/members/me"
+	ath := AccessTokenHash("access-token")
+
+	// 정상 proof (query 는 htu 비교에서 제외)
+	proof := newDPoPProof(t, key, "jti-1", "GET", htu, now, ath)
+	jkt, err := verifier.VerifyProof(proof, "GET", htu+"?page=1", "access-token")
+	if err != nil || jkt == "" {
+		t.Fatalf("정상 proof 검증 실패: %v", err)
+	}
+
+	// 같은 키는 같은 thumbprint
+	other := newDPoPProof(t, key, "jti-2", "GET", htu, now, ath)
+	if otherJkt, _ := verifier.VerifyProof(other, "GET", htu, "access-token"); otherJkt != jkt {
+		t.Errorf("jkt 불일치: %s / %s", otherJkt, jkt)
+	}
+
+	// jti 재사용
+	if _, err := verifier.VerifyProof(proof, "GET", htu, "access-token"); err != ErrDPoPProofReplayed {
+		t.Errorf("재사용 proof 허용됨: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		proof  string
+		method string
+		url    string
+		access string
+	}{
+		{"htm 불일치", newDPoPProof(t, key, "jti-3", "POST", htu, now, ath), "GET", htu, "access-token"},
+		{"htu 불일치", newDPoPProof(t, key, "jti-4", "GET", htu, now, ath), "GET", "https://api.example.com/other", "access-token"},
+		{"ath 불일치", newDPoPProof(t, key, "jti-5", "GET", htu, now, ath), "GET", htu, "other-token"},
+		{"iat 범위 초과", newDPoPProof(t, key, "jti-6", "GET", htu, now.Add(-2*time.Minute), ath), "GET", htu, "access-token"},
+		{"proof 누락", "", "GET", htu, "access-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verifier.VerifyProof(tt.proof, tt.method, tt.url, tt.access); err != ErrDPoPProofInvalid {
+				t.Errorf("잘못된 proof 허용됨: %v", err)
+			}
+		})
+	}
+}
@@ -1,7 +1,8 @@
 package auth
 
 import (
-	"study/internal/feature/member"
+	"study/internal/shared/model"
+	"study/internal/shared/profileimage"
 )
 
 // 회원가입 요청 DTO
@@ -11,24 +12,76 @@ type SignUpRequest struct {
 	Name     string `json:"name"`
 }
 
+// 회원가입 응답 DTO
+type SignUpResponse struct {
+	ID              int64        `json:"id"`
+	Status          model.Status `json:"status"`          // ACTIVE | READY (승인 대기)
+	PendingApproval bool         `json:"pendingApproval"` // 관리자 승인 전까지 로그인 불가
+}
+
 // 로그인 요청 DTO
 type LoginRequest struct {
 	Email      string `json:"email"`
 	Password   string `json:"password"`
 	RememberMe bool   `json:"rememberMe" default:"false"`
+	DPoPJkt    string `json:"-"` // DPoP proof 공개키 thumbprint (헤더에서 추출)
+}
+
+// 비밀번호 변경 요청 DTO
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// 재인증 요청 DTO
+type ReauthenticateRequest struct {
+	Password string `json:"password"`
+}
+
+// 재인증 응답 DTO (auth_time 이 갱신된 단기 access 토큰)
+type ReauthenticateResponse struct {
+	AccessToken string `json:"accessToken,omitempty"`
+	TokenType   string `json:"tokenType"`
+	ExpiresIn   int    `json:"expiresIn"`
+}
+
+// 로그인 상태 응답 DTO (익명 요청은 authenticated=false)
+type AuthStatusResponse struct {
+	Authenticated         bool  `json:"authenticated"`
+	MemberID              int64 `json:"memberId,omitempty"`
+	RecentlyAuthenticated bool  `json:"recentlyAuthenticated"`
 }
 
 // 멤버 전달 객체
 type MemberResponse struct {
-	ID      int64         `json:"id"`
-	Email   string        `json:"email"`
-	Profile *string       `json:"profile"`
-	Roles   []member.Role `json:"roles"`
+	ID           int64                  `json:"id"`
+	Email        string                 `json:"email"`
+	ProfileImage *profileimage.Response `json:"profileImage"` // 기간 제한 다운로드 URL
+	Roles        []model.Role           `json:"roles"`
 }
 
 // 로그인 응답 DTO
 type LoginResponse struct {
-	AccessToken  string         `json:"accessToken"`
-	RefreshToken string         `json:"refreshToken"`
-	Member       MemberResponse `json:"member"`
+	AccessToken     string         `json:"accessToken"`
+	RefreshToken    string         `json:"refreshToken"`
+	TokenType       string         `json:"tokenType"`
+	CsrfToken       string         `json:"csrfToken,omitempty"`
+	PasswordExpired bool           `json:"passwordExpired"`
+	Member          MemberResponse `json:"member"`
+}
+
+// 토큰 인트로스펙션 응답 DTO (RFC 7662)
+type IntrospectionResponse struct {
+	Active    bool          `json:"active"`
+	Scope     string        `json:"scope,omitempty"`
+	TokenType string        `json:"token_type,omitempty"`
+	ClientID  string        `json:"client_id,omitempty"`
+	Sub       string        `json:"sub,omitempty"`
+	Iss       string        `json:"iss,omitempty"`
+	Aud       []string      `json:"aud,omitempty"`
+	Exp       int64         `json:"exp,omitempty"`
+	Iat       int64         `json:"iat,omitempty"`
+	Nbf       int64         `json:"nbf,omitempty"`
+	Jti       string        `json:"jti,omitempty"`
+	Cnf       *Confirmation `json:"cnf,omitempty"`
 }
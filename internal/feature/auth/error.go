@@ -10,15 +10,54 @@ var (
 	// 이메일 또는 비밀번호가 일치하지 않음
 	ErrInvalidCredential = errors.New("INVALID_CREDENTIAL")
 
+	// 인증 제공자(LDAP 등) 장애
+	ErrAuthenticatorUnavailable = errors.New("AUTHENTICATOR_UNAVAILABLE")
+
+	// 비활성/삭제된 회원 (SCIM 비활성화 등)
+	ErrMemberDisabled = errors.New("MEMBER_DISABLED")
+
+	// 가입 승인 대기 (signup.mode: approval)
+	ErrPendingApproval = errors.New("PENDING_APPROVAL")
+
+	// 최근 자격 증명 확인 필요 (민감 작업)
+	ErrReauthRequired = errors.New("REAUTH_REQUIRED")
+
 	// 토큰 만료
 	ErrTokenExpired = errors.New("TOKEN_EXPIRED")
 
 	// 토큰 유효하지 않음
 	ErrTokenInvalid = errors.New("TOKEN_INVALID")
 
+	// 세션 만료 (유휴 / 절대 만료, 폐기)
+	ErrSessionExpired = errors.New("SESSION_EXPIRED")
+
+	// access 자동 재발급 중 세션 폐기 / 만료 확인 (refresh 재시도 불가, 다시 로그인)
+	ErrLoginRequired = errors.New("LOGIN_REQUIRED")
+
 	// 토큰 타입 불일치
 	ErrTokenTypeWrong = errors.New("TOKEN_TYPE_WRONG")
 
+	// 비밀번호 만료 (비밀번호 변경만 허용)
+	ErrPasswordExpired = errors.New("PASSWORD_EXPIRED")
+
+	// 최근 사용한 비밀번호 재사용
+	ErrPasswordReused = errors.New("PASSWORD_REUSED")
+
+	// DPoP proof 누락 또는 검증 실패
+	ErrDPoPProofInvalid = errors.New("INVALID_DPOP_PROOF")
+
+	// 이미 사용된 DPoP proof (jti 재사용)
+	ErrDPoPProofReplayed = errors.New("DPOP_PROOF_REPLAYED")
+
+	// DPoP 필수 (Bearer 비허용 또는 DPoP 바인딩 토큰)
+	ErrDPoPRequired = errors.New("DPOP_REQUIRED")
+
+	// 토큰에 바인딩된 키와 proof 키 불일치
+	ErrDPoPKeyMismatch = errors.New("DPOP_KEY_MISMATCH")
+
+	// CSRF 토큰 누락 또는 불일치
+	ErrCsrfTokenInvalid = errors.New("CSRF_TOKEN_INVALID")
+
 	// 쿠키 누락
 	ErrCookieNotFound = errors.New("COOKIE_NOT_FOUND")
 )
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"maps"
+
+	"study/internal/observability"
+	"study/internal/shared/model"
+	"study/pkg/log"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 훅 실행 시점
+type HookEvent string
+
+const (
+	HookRegister       HookEvent = "register"
+	HookLogin          HookEvent = "login"
+	HookRefresh        HookEvent = "refresh"
+	HookReauthenticate HookEvent = "reauthenticate"
+)
+
+// 훅에 전달되는 인증 정보 (읽기 전용으로 사용)
+type HookContext struct {
+	Event    HookEvent
+	MemberID int64 // 회원가입 사전 훅은 0
+	Email    string
+	Name     string // 회원가입만
+	Provider string // 로그인 / 재인증만 (local, ldap 등)
+	Roles    []model.Role
+}
+
+// 사전 훅: 에러를 반환하면 요청 거부 (에러 문자열이 응답 코드, 예: errors.New("SIGNUP_DOMAIN_BLOCKED"))
+type PreHook func(ctx context.Context, hc *HookContext) error
+
+// 클레임 훅: access 토큰에 추가할 클레임 반환 (ext.<namespace> 아래 저장)
+type ClaimHook func(ctx context.Context, hc *HookContext) (map[string]any, error)
+
+// 사후 훅: 성공 후 실행 (에러는 로그만 남기고 응답에 영향 없음)
+type PostHook func(ctx context.Context, hc *HookContext) error
+
+type preHook struct {
+	name string
+	run  PreHook
+}
+
+type claimHook struct {
+	namespace string
+	run       ClaimHook
+}
+
+type postHook struct {
+	name string
+	run  PostHook
+}
+
+// 인증 훅 레지스트리
+// - 라우터 구성 시점에 등록하고 이후에는 읽기만 함 (동기 실행, 등록 순서대로)
+// - 클레임 훅은 로그인 / 토큰 재발급 / 재인증 시 모두 실행해 새로 발급하는 토큰에도 유지
+type HookRegistry struct {
+	pre    map[HookEvent][]preHook
+	claims []claimHook
+	post   map[HookEvent][]postHook
+}
+
+func NewHookRegistry() *HookRegistry {
+	return &HookRegistry{
+		pre:  map[HookEvent][]preHook{},
+		post: map[HookEvent][]postHook{},
+	}
+}
+
+// 사전 훅 등록
+func (r *HookRegistry) Before(event HookEvent, name string, hook PreHook) {
+	r.pre[event] = append(r.pre[event], preHook{name: name, run: hook})
+}
+
+// 클레임 훅 등록 (namespace 는 기능 이름, 기능 간 클레임 충돌 방지)
+func (r *HookRegistry) Claims(namespace string, hook ClaimHook) {
+	r.claims = append(r.claims, claimHook{namespace: namespace, run: hook})
+}
+
+// 사후 훅 등록
+func (r *HookRegistry) After(event HookEvent, name string, hook PostHook) {
+	r.post[event] = append(r.post[event], postHook{name: name, run: hook})
+}
+
+// 사전 훅 실행 (첫 거부에서 중단)
+func (r *HookRegistry) runPre(ctx context.Context, hc *HookContext) error {
+	if r == nil {
+		return nil
+	}
+
+	for _, h := range r.pre[hc.Event] {
+		hookCtx, span, start := observability.StartServiceSpan(ctx, "Hook."+h.name)
+		span.SetAttributes(attribute.String("hook.event", string(hc.Event)), attribute.String("hook.stage", "pre"))
+
+		err := h.run(hookCtx, hc)
+		observability.RecordBusinessError(span, err)
+		observability.EndSpanWithLatency(span, start, 50)
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 클레임 훅 실행 (없으면 nil)
+func (r *HookRegistry) runClaims(ctx context.Context, hc *HookContext) (map[string]any, error) {
+	if r == nil || len(r.claims) == 0 {
+		return nil, nil
+	}
+
+	ext := map[string]any{}
+	for _, h := range r.claims {
+		hookCtx, span, start := observability.StartServiceSpan(ctx, "Hook."+h.namespace)
+		span.SetAttributes(attribute.String("hook.event", string(hc.Event)), attribute.String("hook.stage", "claims"))
+
+		claims, err := h.run(hookCtx, hc)
+		observability.RecordServiceError(span, err)
+		observability.EndSpanWithLatency(span, start, 50)
+
+		if err != nil {
+			return nil, err
+		}
+		if len(claims) > 0 {
+			ext[h.namespace] = maps.Clone(claims)
+		}
+	}
+
+	if len(ext) == 0 {
+		return nil, nil
+	}
+	return ext, nil
+}
+
+// 사후 훅 실행 (실패해도 다음 훅 계속)
+func (r *HookRegistry) runPost(ctx context.Context, hc *HookContext) {
+	if r == nil {
+		return
+	}
+
+	for _, h := range r.post[hc.Event] {
+		hookCtx, span, start := observability.StartServiceSpan(ctx, "Hook."+h.name)
+		span.SetAttributes(attribute.String("hook.event", string(hc.Event)), attribute.String("hook.stage", "post"))
+
+		if err := h.run(hookCtx, hc); err != nil {
+			observability.RecordServiceError(span, err)
+			log.ErrorCtx(hookCtx, "인증 사후 훅 실패", log.MapStr("hook", h.name), log.MapErr("error", err))
+		}
+		observability.EndSpanWithLatency(span, start, 50)
+	}
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHookRegistry(t *testing.T) {
+	ctx := context.Background()
+	errBlocked := errors.New("SIGNUP_DOMAIN_BLOCKED")
+
+	hooks := NewHookRegistry()
+	var calls []string
+
+	hooks.Before(HookRegister, "domain", func(ctx context.Context, hc *HookContext) error {
+		calls = append(calls, "domain")
+		if hc.Email == "user@blocked.com" {
+			return errBlocked
+		}
+		return nil
+	})
+	hooks.Before(HookRegister, "audit", func(ctx context.Context, hc *HookContext) error {
+		calls = append(calls, "audit")
+		return nil
+	})
+
+	// 첫 거부에서 중단, 에러 코드 그대로 전달
+	if err := hooks.runPre(ctx, &HookContext{Event: HookRegister, Email: "user@blocked.com"}); err != errBlocked {
+		t.Errorf("사전 훅 거부 기대, 결과: %v", err)
+	}
+	if len(calls) != 1 {
+		t.Errorf("거부 이후 훅 실행됨: %v", calls)
+	}
+
+	// 다른 이벤트 훅은 실행하지 않음
+	calls = nil
+	if err := hooks.runPre(ctx, &HookContext{Event: HookLogin, Email: "user@blocked.com"}); err != nil || len(calls) != 0 {
+		t.Errorf("로그인 이벤트에서 가입 훅 실행: %v %v", err, calls)
+	}
+
+	// 클레임은 namespace 아래에 저장, 빈 결과는 생략
+	hooks.Claims("billing", func(ctx context.Context, hc *HookContext) (map[string]any, error) {
+		return map[string]any{"plan": "pro"}, nil
+	})
+	hooks.Claims("empty", func(ctx context.Context, hc *HookContext) (map[string]any, error) {
+		return nil, nil
+	})
+	ext, err := hooks.runClaims(ctx, &HookContext{Event: HookLogin, MemberID: 1})
+	if err != nil {
+		t.Fatalf("클레임 훅 실패: %v", err)
+	}
+	billing, _ := ext["billing"].(map[string]any)
+	if len(ext) != 1 || billing["plan"] != "pro" {
+		t.Errorf("namespace 클레임 불일치: %v", ext)
+	}
+
+	// 사후 훅 실패는 다음 훅 실행을 막지 않음
+	calls = nil
+	hooks.After(HookLogin, "fail", func(ctx context.Context, hc *HookContext) error {
+		calls = append(calls, "fail")
+		return errors.New("WEBHOOK_FAILED")
+	})
+	hooks.After(HookLogin, "notify", func(ctx context.Context, hc *HookContext) error {
+		calls = append(calls, "notify")
+		return nil
+	})
+	hooks.runPost(ctx, &HookContext{Event: HookLogin, MemberID: 1})
+	if len(calls) != 2 {
+		t.Errorf("사후 훅 전체 실행 기대: %v", calls)
+	}
+
+	// 등록하지 않은 레지스트리는 아무것도 하지 않음
+	var none *HookRegistry
+	if err := none.runPre(ctx, &HookContext{Event: HookLogin}); err != nil {
+		t.Errorf("nil 레지스트리 사전 훅: %v", err)
+	}
+	if ext, err := none.runClaims(ctx, &HookContext{Event: HookLogin}); ext != nil || err != nil {
+		t.Errorf("nil 레지스트리 클레임: %v %v", ext, err)
+	}
+}
+
+func TestHookClaimsInAccessToken(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+
+	ext := map[string]any{"billing": map[string]any{"plan": "pro"}}
+	login, err := jwtService.Login(context.Background(), 1, "session", "", ext)
+	if err != nil {
+		t.Fatalf("로그인 토큰 생성 실패: %v", err)
+	}
+
+	claims, err := jwtService.VerifyAccessToken(context.Background(), login.AccessToken)
+	if err != nil {
+		t.Fatalf("Access Token 검증 실패: %v", err)
+	}
+	billing, _ := claims.Ext["billing"].(map[string]any)
+	if billing["plan"] != "pro" {
+		t.Errorf("access 토큰 훅 클레임 누락: %v", claims.Ext)
+	}
+
+	// refresh 토큰에는 싣지 않음 (재발급 시 다시 계산)
+	refresh, err := jwtService.VerifyRefreshToken(login.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh Token 검증 실패: %v", err)
+	}
+	if refresh.Ext != nil {
+		t.Errorf("refresh 토큰에 훅 클레임 포함: %v", refresh.Ext)
+	}
+
+	// 재인증 토큰에도 유지
+	elevated, err := jwtService.GenerateElevatedAccessToken(context.Background(), 1, "", ext)
+	if err != nil {
+		t.Fatalf("재인증 토큰 생성 실패: %v", err)
+	}
+	elevatedClaims, err := jwtService.VerifyAccessToken(context.Background(), elevated)
+	if err != nil {
+		t.Fatalf("재인증 토큰 검증 실패: %v", err)
+	}
+	billing, _ = elevatedClaims.Ext["billing"].(map[string]any)
+	if billing["plan"] != "pro" {
+		t.Errorf("재인증 토큰 훅 클레임 누락: %v", elevatedClaims.Ext)
+	}
+}
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"strconv"
+	"study/internal/config"
+	"study/pkg/log"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDC ID 토큰 Payload
+type IDTokenClaims struct {
+	Nonce           string `json:"nonce,omitempty"`
+	AuthorizedParty string `json:"azp,omitempty"`
+	AccessTokenHash string `json:"at_hash,omitempty"`
+	Email           string `json:"email,omitempty"`
+	Name            string `json:"name,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// JWKS 응답 (RFC 7517)
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// ID 토큰 서명 (ES256)
+// - access / refresh 토큰(HS256)과 달리 클라이언트가 JWKS 로 검증할 수 있도록 비대칭 키 사용
+type IDTokenSigner struct {
+	key      *ecdsa.PrivateKey
+	kid      string
+	issuer   string
+	expireIn time.Duration
+	now      func() time.Time
+}
+
+// 키 파일이 없으면 임시 키 생성 (재기동 시 기존 ID 토큰 검증 불가, 개발용)
+func NewIDTokenSigner(cfg *config.OIDC) (*IDTokenSigner, error) {
+	var (
+		key *ecdsa.PrivateKey
+		err error
+	)
+
+	if cfg.SigningKeyFile == "" {
+		log.Warn("OIDC 서명 키 미설정, 임시 키를 생성합니다")
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		key, err = loadECPrivateKey(cfg.SigningKeyFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s := &IDTokenSigner{
+		key:      key,
+		issuer:   cfg.Issuer,
+		expireIn: time.Duration(cfg.IDTokenExpireMin) * time.Minute,
+		now:      time.Now,
+	}
+
+	// kid 는 공개키 thumbprint (RFC 7638)
+	jwk := s.publicJWK()
+	s.kid, err = (&dpopJWK{Kty: jwk.Kty, Crv: jwk.Crv, X: jwk.X, Y: jwk.Y}).thumbprint()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ID 토큰 발급 (iss, sub, aud, exp, iat 는 서명기가 설정)
+func (s *IDTokenSigner) Sign(memberID int64, clientID string, claims IDTokenClaims) (string, error) {
+	now := s.now()
+
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    s.issuer,
+		Subject:   strconv.FormatInt(memberID, 10),
+		Audience:  jwt.ClaimStrings{clientID},
+		ExpiresAt: jwt.NewNumericDate(now.Add(s.expireIn)),
+		IssuedAt:  jwt.NewNumericDate(now),
+	}
+	claims.AuthorizedParty = clientID
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = s.kid
+
+	return token.SignedString(s.key)
+}
+
+// 서명 검증용 공개키 목록
+func (s *IDTokenSigner) JWKS() JWKSet {
+	jwk := s.publicJWK()
+	jwk.Kid = s.kid
+	return JWKSet{Keys: []JWK{jwk}}
+}
+
+// ID 토큰 서명 알고리즘
+func (s *IDTokenSigner) Algorithm() string {
+	return jwt.SigningMethodES256.Alg()
+}
+
+func (s *IDTokenSigner) publicJWK() JWK {
+	size := (s.key.Curve.Params().BitSize + 7) / 8
+	return JWK{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(s.key.PublicKey.X.FillBytes(make([]byte, size))),
+		Y:   base64.RawURLEncoding.EncodeToString(s.key.PublicKey.Y.FillBytes(make([]byte, size))),
+		Use: "sig",
+		Alg: jwt.SigningMethodES256.Alg(),
+	}
+}
+
+// PEM (SEC1 / PKCS#8) EC P-256 개인키 로드
+func loadECPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("OIDC 서명 키 PEM 형식 오류")
+	}
+
+	var key any
+	if block.Type == "EC PRIVATE KEY" {
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok || ecKey.Curve != elliptic.P256() {
+		return nil, errors.New("OIDC 서명 키는 EC P-256 이어야 합니다")
+	}
+
+	return ecKey, nil
+}
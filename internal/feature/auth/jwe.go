@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"crypto/sha256"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// access 토큰 암호화 방식 (JWE, 서명된 JWT 를 그대로 암호화하는 중첩 JWT)
+const (
+	TokenEncryptionNone    = "none"
+	TokenEncryptionDirect  = "dir"    // 공유 키로 콘텐츠 직접 암호화
+	TokenEncryptionKeyWrap = "A256KW" // 토큰마다 생성한 CEK 를 공유 키로 래핑
+)
+
+// 서명된 access 토큰 암호화 / 복호화
+// - 콘텐츠 암호화는 A256GCM 고정, 키는 시크릿의 SHA-256 (쿠키 암호화와 동일)
+type tokenEncrypter struct {
+	alg jose.KeyAlgorithm
+	key []byte
+}
+
+// 암호화 방식에 맞는 tokenEncrypter 생성 (none 이면 nil)
+func newTokenEncrypter(mode string, secret []byte) *tokenEncrypter {
+	var alg jose.KeyAlgorithm
+
+	switch mode {
+	case TokenEncryptionDirect:
+		alg = jose.DIRECT
+	case TokenEncryptionKeyWrap:
+		alg = jose.A256KW
+	default:
+		return nil
+	}
+
+	key := sha256.Sum256(secret)
+	return &tokenEncrypter{alg: alg, key: key[:]}
+}
+
+// 서명된 JWT 를 JWE Compact 로 암호화 (cty=JWT)
+func (e *tokenEncrypter) encrypt(signed string) (string, error) {
+	opts := (&jose.EncrypterOptions{}).WithType("JWT").WithContentType("JWT")
+
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: e.alg, Key: e.key}, opts)
+	if err != nil {
+		return "", err
+	}
+
+	object, err := encrypter.Encrypt([]byte(signed))
+	if err != nil {
+		return "", err
+	}
+	return object.CompactSerialize()
+}
+
+// JWE Compact 복호화 후 내부 JWT 반환 (설정한 알고리즘 외에는 거부)
+func (e *tokenEncrypter) decrypt(token string) (string, error) {
+	object, err := jose.ParseEncryptedCompact(token, []jose.KeyAlgorithm{e.alg}, []jose.ContentEncryption{jose.A256GCM})
+	if err != nil {
+		return "", ErrTokenInvalid
+	}
+
+	if cty, _ := object.Header.ExtraHeaders[jose.HeaderContentType].(string); cty != "JWT" {
+		return "", ErrTokenInvalid
+	}
+
+	signed, err := object.Decrypt(e.key)
+	if err != nil {
+		return "", ErrTokenInvalid
+	}
+	return string(signed), nil
+}
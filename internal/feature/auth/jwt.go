@@ -1,35 +1,86 @@
 package auth
 
 import (
+	"context"
 	"errors"
+	"strconv"
 	"study/internal/config"
+	"study/pkg/util"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// 미들웨어가 검증된 Claims를 저장하는 Locals 키
+const ClaimsKey = "claims"
+
 // JWT(access / refresh) 생성 및 검증을 담당하는 서비스
 type JwtService struct {
 	accessSecret     []byte
 	refreshSecret    []byte
 	accessExpireMin  int
 	refreshExpireDay int
+	reauthMaxAge     time.Duration
+	issuer           string
+	audience         []string
+	leeway           time.Duration
+	now              func() time.Time
+	accessTokenMode  string
+	opaque           *OpaqueTokenStore
+	encrypter        *tokenEncrypter // access 토큰 JWE 암호화 (nil 이면 서명만)
+}
+
+// JwtService 생성 옵션
+type JwtOption func(*JwtService)
+
+// 현재 시각 함수 주입 (테스트에서 만료 검증 시 사용)
+func WithClock(now func() time.Time) JwtOption {
+	return func(j *JwtService) {
+		j.now = now
+	}
+}
+
+// 불투명 access 토큰 저장소 주입 (AccessTokenMode=opaque 일 때 사용)
+func WithOpaqueStore(store *OpaqueTokenStore) JwtOption {
+	return func(j *JwtService) {
+		j.opaque = store
+	}
 }
 
 // JWT 설정값을 기반으로 JwtService 생성
-func NewJwtService(cfg *config.JWT) *JwtService {
-	return &JwtService{
+func NewJwtService(cfg *config.JWT, opts ...JwtOption) *JwtService {
+	j := &JwtService{
 		accessSecret:     cfg.AccessSecret,
 		refreshSecret:    cfg.RefreshSecret,
 		accessExpireMin:  cfg.AccessExpireMin,
 		refreshExpireDay: cfg.RefreshExpireDay,
+		reauthMaxAge:     time.Duration(cfg.ReauthMaxAgeMin) * time.Minute,
+		issuer:           cfg.Issuer,
+		audience:         cfg.Audience,
+		leeway:           time.Duration(cfg.LeewaySec) * time.Second,
+		now:              time.Now,
+		accessTokenMode:  cfg.AccessTokenMode,
+		encrypter:        newTokenEncrypter(cfg.Encryption, cfg.EncryptionSecret),
 	}
+
+	for _, opt := range opts {
+		opt(j)
+	}
+
+	return j
 }
 
 // JWT Payload에 담기는 공통 클레임 구조
 type Claims struct {
-	MemberID int64     `json:"memberId"`
-	Type     TokenType `json:"type"`
+	MemberID     int64            `json:"memberId"`
+	Type         TokenType        `json:"type"`
+	Scope        TokenScope       `json:"scope,omitempty"`
+	SessionID    string           `json:"sid,omitempty"`       // 리프레쉬 세션 ID (refresh 토큰 전용)
+	ClientID     string           `json:"client_id,omitempty"` // OAuth 클라이언트 발급 토큰 (자체 로그인은 "")
+	Confirmation *Confirmation    `json:"cnf,omitempty"`       // DPoP 키 바인딩 (없으면 Bearer 토큰)
+	AuthTime     *jwt.NumericDate `json:"auth_time,omitempty"` // 마지막 자격 증명 확인 시각 (로그인 / 재인증)
+	Ext          map[string]any   `json:"ext,omitempty"`       // 인증 훅이 추가한 클레임 (기능 namespace 별)
 	jwt.RegisteredClaims
 }
 
@@ -41,6 +92,14 @@ const (
 	TypeRefresh TokenType = "REFRESH"
 )
 
+// 토큰 사용 범위 (비어있으면 제한 없음)
+type TokenScope string
+
+const (
+	// 비밀번호 만료 상태에서 비밀번호 변경만 허용
+	ScopePasswordChange TokenScope = "PASSWORD_CHANGE"
+)
+
 // access 토큰 상태 분류 (미들웨어용)
 type TokenStatus int
 
@@ -50,23 +109,71 @@ const (
 	TokenInvalid
 )
 
-// Access Token 생성
-func (j *JwtService) GenerateAccessToken(memberID int64) (string, error) {
-	return j.generateToken(memberID, TypeAccess)
+// Access Token 생성 (jkt 가 있으면 DPoP 키에 바인딩)
+func (j *JwtService) GenerateAccessToken(ctx context.Context, memberID int64, jkt string) (string, error) {
+	return j.generateToken(ctx, TypeAccess, Claims{MemberID: memberID, Confirmation: confirmation(jkt)})
 }
 
-// Refresh Token 생성
-func (j *JwtService) GenerateRefreshToken(memberID int64) (string, error) {
-	return j.generateToken(memberID, TypeRefresh)
+// 로그인 시각을 유지한 Access Token 생성 (refresh 토큰으로 재발급 시, ext 는 인증 훅 클레임)
+func (j *JwtService) GenerateAccessTokenAt(ctx context.Context, memberID int64, jkt string, authTime *jwt.NumericDate, ext map[string]any) (string, error) {
+	return j.generateToken(ctx, TypeAccess, Claims{MemberID: memberID, Confirmation: confirmation(jkt), AuthTime: authTime, Ext: ext})
 }
 
-// 로그인
-func (j *JwtService) Login(memberID int64) (*LoginResponse, error) {
-	accessToken, err := j.GenerateAccessToken(memberID)
+// 재인증 직후 발급하는 Access Token (auth_time 갱신, 재인증 유효 시간만큼만 유효, ext 는 인증 훅 클레임)
+func (j *JwtService) GenerateElevatedAccessToken(ctx context.Context, memberID int64, jkt string, ext map[string]any) (string, error) {
+	claims := Claims{MemberID: memberID, Confirmation: confirmation(jkt), AuthTime: jwt.NewNumericDate(j.now()), Ext: ext}
+	return j.signToken(ctx, TypeAccess, claims, j.reauthMaxAge)
+}
+
+// 재인증 유효 시간 (초)
+func (j *JwtService) ReauthExpiresIn() int {
+	return int(j.reauthMaxAge / time.Second)
+}
+
+// 최근 자격 증명 확인 여부 (auth_time 이 재인증 유효 시간 이내)
+func (j *JwtService) RecentlyAuthenticated(claims *Claims) bool {
+	if claims == nil || claims.AuthTime == nil {
+		return false
+	}
+	return j.now().Before(claims.AuthTime.Add(j.reauthMaxAge))
+}
+
+// 비밀번호 변경 전용 Access Token 생성
+// - 로그인 직후 발급하므로 auth_time 기록, jkt 가 있으면 DPoP 키에 바인딩 (Bearer 미허용 환경 대응)
+func (j *JwtService) GeneratePasswordChangeToken(ctx context.Context, memberID int64, jkt string) (string, error) {
+	claims := Claims{MemberID: memberID, Confirmation: confirmation(jkt), AuthTime: jwt.NewNumericDate(j.now()), Scope: ScopePasswordChange}
+	return j.generateToken(ctx, TypeAccess, claims)
+}
+
+// Refresh Token 생성 (리프레쉬 세션에 귀속, jkt 가 있으면 DPoP 키에 바인딩)
+func (j *JwtService) GenerateRefreshToken(memberID int64, sessionID string, jkt string) (string, error) {
+	return j.generateToken(context.Background(), TypeRefresh, Claims{MemberID: memberID, SessionID: sessionID, Confirmation: confirmation(jkt)})
+}
+
+// OAuth 클라이언트용 Access Token 생성 (memberID 가 0 이면 client_credentials 토큰)
+func (j *JwtService) GenerateClientAccessToken(ctx context.Context, memberID int64, clientID string, scope string) (string, error) {
+	return j.generateToken(ctx, TypeAccess, Claims{MemberID: memberID, ClientID: clientID, Scope: TokenScope(scope)})
+}
+
+// OAuth 클라이언트용 Refresh Token 생성 (리프레쉬 세션에 귀속)
+func (j *JwtService) GenerateClientRefreshToken(memberID int64, sessionID string, clientID string, scope string) (string, error) {
+	return j.generateToken(context.Background(), TypeRefresh, Claims{MemberID: memberID, SessionID: sessionID, ClientID: clientID, Scope: TokenScope(scope)})
+}
+
+// access 토큰 유효 시간 (초, OAuth expires_in)
+func (j *JwtService) AccessExpiresIn() int {
+	return j.accessExpireMin * 60
+}
+
+// 로그인 (access / refresh 모두 로그인 시각을 auth_time 으로 보관, ext 는 access 토큰에만)
+func (j *JwtService) Login(ctx context.Context, memberID int64, sessionID string, jkt string, ext map[string]any) (*LoginResponse, error) {
+	authTime := jwt.NewNumericDate(j.now())
+
+	accessToken, err := j.GenerateAccessTokenAt(ctx, memberID, jkt, authTime, ext)
 	if err != nil {
 		return nil, err
 	}
-	refreshToken, err := j.GenerateRefreshToken(memberID)
+	refreshToken, err := j.generateToken(ctx, TypeRefresh, Claims{MemberID: memberID, SessionID: sessionID, Confirmation: confirmation(jkt), AuthTime: authTime})
 	if err != nil {
 		return nil, err
 	}
@@ -74,11 +181,47 @@ func (j *JwtService) Login(memberID int64) (*LoginResponse, error) {
 	return &LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		TokenType:    tokenScheme(jkt),
 	}, nil
 }
 
-// access 토큰 검증 및 Claims 반환
-func (j *JwtService) VerifyAccessToken(tokenStr string) (*Claims, error) {
+// jkt → cnf 클레임
+func confirmation(jkt string) *Confirmation {
+	if jkt == "" {
+		return nil
+	}
+	return &Confirmation{JKT: jkt}
+}
+
+// 응답 tokenType (DPoP 바인딩 여부)
+func tokenScheme(jkt string) string {
+	if jkt == "" {
+		return TokenSchemeBearer
+	}
+	return TokenSchemeDPoP
+}
+
+// NumericDate → unix seconds (없으면 0)
+func numericDate(d *jwt.NumericDate) int64 {
+	if d == nil {
+		return 0
+	}
+	return d.Unix()
+}
+
+// 토큰에 바인딩된 DPoP 키 thumbprint (없으면 "")
+func (c *Claims) BoundKey() string {
+	if c.Confirmation == nil {
+		return ""
+	}
+	return c.Confirmation.JKT
+}
+
+// access 토큰 검증 및 Claims 반환 (발급 방식에 따라 JWT 검증 또는 저장소 조회)
+func (j *JwtService) VerifyAccessToken(ctx context.Context, tokenStr string) (*Claims, error) {
+	if j.isOpaque() {
+		return j.verifyOpaque(ctx, tokenStr)
+	}
 	return j.verifyToken(tokenStr, TypeAccess)
 }
 
@@ -88,41 +231,111 @@ func (j *JwtService) VerifyRefreshToken(tokenStr string) (*Claims, error) {
 }
 
 // 토큰 생성 공통 로직 (access / refresh)
-func (j *JwtService) generateToken(memberID int64, tokenType TokenType) (string, error) {
-	var (
-		secret     []byte
-		expireTime time.Time
-	)
-
-	now := time.Now()
-
+func (j *JwtService) generateToken(ctx context.Context, tokenType TokenType, claims Claims) (string, error) {
 	switch tokenType {
 	case TypeAccess:
-		secret = j.accessSecret
-		expireTime = now.Add(time.Duration(j.accessExpireMin) * time.Minute)
+		return j.signToken(ctx, tokenType, claims, time.Duration(j.accessExpireMin)*time.Minute)
 
 	case TypeRefresh:
-		secret = j.refreshSecret
-		expireTime = now.Add(time.Duration(j.refreshExpireDay) * 24 * time.Hour)
+		return j.signToken(ctx, tokenType, claims, time.Duration(j.refreshExpireDay)*24*time.Hour)
 
 	default:
 		return "", jwt.ErrTokenInvalidClaims
 	}
+}
 
-	claims := Claims{
-		MemberID: memberID,
-		Type:     tokenType,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expireTime),
-			IssuedAt:  jwt.NewNumericDate(now),
-		},
+// 유효 시간을 지정한 토큰 서명 (불투명 모드 access 토큰은 저장소 발급)
+func (j *JwtService) signToken(ctx context.Context, tokenType TokenType, claims Claims, ttl time.Duration) (string, error) {
+	secret := j.accessSecret
+	if tokenType == TypeRefresh {
+		secret = j.refreshSecret
 	}
 
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+	now := j.now()
+	expireTime := now.Add(ttl)
+
+	// 토큰 고유 ID (jti)
+	tokenID, err := util.RandomString(16)
+	if err != nil {
+		return "", err
+	}
+
+	// 회원이 없는 토큰(client_credentials)은 클라이언트가 주체
+	subject := strconv.FormatInt(claims.MemberID, 10)
+	if claims.MemberID == 0 && claims.ClientID != "" {
+		subject = claims.ClientID
+	}
+
+	claims.Type = tokenType
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    j.issuer,
+		Subject:   subject,
+		Audience:  j.audience,
+		ExpiresAt: jwt.NewNumericDate(expireTime),
+		NotBefore: jwt.NewNumericDate(now),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ID:        tokenID,
+	}
+
+	// 불투명 access 토큰: Claims 는 저장소에 두고 참조 토큰만 전달
+	if tokenType == TypeAccess && j.isOpaque() {
+		return j.opaque.Issue(ctx, &claims, int(ttl/time.Minute))
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
 		SignedString(secret)
+	if err != nil {
+		return "", err
+	}
+
+	// 암호화 모드 access 토큰: 클라이언트 / 중간자가 Claims 를 읽지 못하도록 JWE 로 감쌈
+	if tokenType == TypeAccess && j.encrypter != nil {
+		return j.encrypter.encrypt(signed)
+	}
+
+	return signed, nil
+}
+
+// 불투명 access 토큰 사용 여부
+func (j *JwtService) isOpaque() bool {
+	return j.accessTokenMode == AccessTokenModeOpaque && j.opaque != nil
+}
+
+// access 토큰 폐기 (불투명 모드만, JWT 는 만료까지 유효)
+func (j *JwtService) RevokeAccessToken(ctx context.Context, tokenStr string) error {
+	if !j.isOpaque() || tokenStr == "" {
+		return nil
+	}
+	return j.opaque.Revoke(ctx, tokenStr)
+}
+
+// 회원의 모든 access 토큰 폐기 (불투명 모드만)
+func (j *JwtService) RevokeAccessTokens(ctx context.Context, memberID int64) error {
+	if !j.isOpaque() {
+		return nil
+	}
+	return j.opaque.RevokeAll(ctx, memberID)
+}
+
+// 불투명 토큰 검증 (저장소 조회 후 JWT 와 같은 시계/허용 오차로 만료 판단)
+func (j *JwtService) verifyOpaque(ctx context.Context, tokenStr string) (*Claims, error) {
+	claims, err := j.opaque.Lookup(ctx, tokenStr)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	if claims.ExpiresAt == nil || j.now().After(claims.ExpiresAt.Time.Add(j.leeway)) {
+		return nil, ErrTokenExpired
+	}
+
+	if claims.Type != TypeAccess {
+		return nil, ErrTokenTypeWrong
+	}
+
+	return claims, nil
 }
 
-// 토큰 검증 공통 로직 (서명, 만료, 타입 검증)
+// 토큰 검증 공통 로직 (복호화, 서명, 만료, 타입 검증)
 func (j *JwtService) verifyToken(tokenStr string, tokenType TokenType) (*Claims, error) {
 	var secret []byte
 
@@ -135,6 +348,15 @@ func (j *JwtService) verifyToken(tokenStr string, tokenType TokenType) (*Claims,
 		return nil, ErrTokenInvalid
 	}
 
+	// 암호화 모드 access 토큰은 복호화 후 내부 JWT 검증 (서명만 된 토큰은 거부)
+	if tokenType == TypeAccess && j.encrypter != nil {
+		signed, err := j.encrypter.decrypt(tokenStr)
+		if err != nil {
+			return nil, err
+		}
+		tokenStr = signed
+	}
+
 	token, err := jwt.ParseWithClaims(
 		tokenStr,
 		&Claims{},
@@ -145,9 +367,10 @@ func (j *JwtService) verifyToken(tokenStr string, tokenType TokenType) (*Claims,
 			}
 			return secret, nil
 		},
+		j.parserOptions()...,
 	)
 
-	// 파싱 에러 처리 (만료 / 위조)
+	// 파싱 에러 처리 (만료 / 위조 / nbf / iss / aud)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, ErrTokenExpired
@@ -166,6 +389,11 @@ func (j *JwtService) verifyToken(tokenStr string, tokenType TokenType) (*Claims,
 		return nil, ErrTokenInvalid
 	}
 
+	// 토큰 고유 ID 필수
+	if claims.ID == "" {
+		return nil, ErrTokenInvalid
+	}
+
 	// 토큰 타입 검증
 	if claims.Type != tokenType {
 		return nil, ErrTokenTypeWrong
@@ -174,9 +402,39 @@ func (j *JwtService) verifyToken(tokenStr string, tokenType TokenType) (*Claims,
 	return claims, nil
 }
 
+// 등록 클레임 검증 옵션 (알고리즘, 시계, 허용 오차, exp/iat 필수, iss/aud)
+func (j *JwtService) parserOptions() []jwt.ParserOption {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithTimeFunc(j.now),
+		jwt.WithLeeway(j.leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+
+	if j.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(j.issuer))
+	}
+	if len(j.audience) > 0 {
+		opts = append(opts, jwt.WithAudience(j.audience...))
+	}
+
+	return opts
+}
+
 // Authorization 헤더에서 Bearer 토큰 추출
 func ExtractBearer(authHeader string) string {
-	const prefix = "Bearer "
+	return extractScheme(authHeader, TokenSchemeBearer)
+}
+
+// Authorization 헤더에서 DPoP 토큰 추출
+func ExtractDPoP(authHeader string) string {
+	return extractScheme(authHeader, TokenSchemeDPoP)
+}
+
+// Authorization 헤더에서 "<scheme> <token>" 형식의 토큰 추출
+func extractScheme(authHeader string, scheme string) string {
+	prefix := scheme + " "
 
 	if authHeader == "" {
 		return ""
@@ -190,9 +448,15 @@ func ExtractBearer(authHeader string) string {
 	return authHeader[len(prefix):]
 }
 
+// 미들웨어에서 저장한 Claims 조회 (없으면 nil)
+func ClaimsFrom(c *fiber.Ctx) *Claims {
+	claims, _ := c.Locals(ClaimsKey).(*Claims)
+	return claims
+}
+
 // access 토큰 상태를 Valid / Expired / Invalid 로 판별
-func (j *JwtService) VerifyStatus(tokenStr string) TokenStatus {
-	_, err := j.VerifyAccessToken(tokenStr)
+func (j *JwtService) VerifyStatus(ctx context.Context, tokenStr string) TokenStatus {
+	_, err := j.VerifyAccessToken(ctx, tokenStr)
 
 	if err == nil {
 		return TokenValid
@@ -207,8 +471,8 @@ func (j *JwtService) VerifyStatus(tokenStr string) TokenStatus {
 }
 
 // 검증된 access 토큰에서 Claims만 추출
-func (j *JwtService) Claims(tokenStr string) *Claims {
-	claims, err := j.VerifyAccessToken(tokenStr)
+func (j *JwtService) Claims(ctx context.Context, tokenStr string) *Claims {
+	claims, err := j.VerifyAccessToken(ctx, tokenStr)
 	if err != nil {
 		return nil
 	}
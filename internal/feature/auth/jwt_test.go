@@ -1,30 +1,262 @@
 package auth
 
 import (
+	"context"
+	"strings"
 	"study/internal/config"
-	"study/pkg/log"
 	"testing"
+	"time"
 )
 
-/*
-JWT_ACCESS_SECRET=ACCESS_SECRET_KEY
-JWT_REFRESH_SECRET=REFRESH_SECRET_KEY
-JWT_ACCESS_EXPIRE_MIN=30
-JWT_REFRESH_EXPIRE_DAY=14
-*/
+// 테스트용 JWT 설정 (access / refresh 시크릿 동일: 타입 검증 확인용)
+func testJwtConfig() *config.JWT {
+	return &config.JWT{
+		AccessSecret:     []byte("ACCESS_SECRET_KEY"),
+		RefreshSecret:    []byte("ACCESS_SECRET_KEY"),
+		AccessExpireMin:  30,
+		RefreshExpireDay: 14,
+		Issuer:           "study",
+		Audience:         []string{"study-api"},
+		LeewaySec:        30,
+		ReauthMaxAgeMin:  5,
+	}
+}
+
+// 고정 시계 (포인터 값을 바꿔 시간 이동)
+func fixedClock(now *time.Time) JwtOption {
+	return WithClock(func() time.Time { return *now })
+}
+
 func TestGenerateAccessToken(t *testing.T) {
-	t.Setenv("APP_ENV", "dev")
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+
+	accessToken, err := jwtService.GenerateAccessToken(context.Background(), 1, "")
+	if err != nil {
+		t.Fatalf("Access Token 생성 실패: %v", err)
+	}
+
+	claims, err := jwtService.VerifyAccessToken(context.Background(), accessToken)
+	if err != nil {
+		t.Fatalf("Access Token 검증 실패: %v", err)
+	}
+
+	if claims.MemberID != 1 || claims.Subject != "1" {
+		t.Errorf("memberId/sub 불일치: %d / %s", claims.MemberID, claims.Subject)
+	}
+	if claims.Issuer != "study" {
+		t.Errorf("iss 불일치: %s", claims.Issuer)
+	}
+	if len(claims.Audience) != 1 || claims.Audience[0] != "study-api" {
+		t.Errorf("aud 불일치: %v", claims.Audience)
+	}
+	if claims.ID == "" {
+		t.Error("jti 누락")
+	}
+	if !claims.NotBefore.Time.Equal(now) || !claims.IssuedAt.Time.Equal(now) {
+		t.Errorf("nbf/iat 불일치: %v / %v", claims.NotBefore, claims.IssuedAt)
+	}
+	if !claims.ExpiresAt.Time.Equal(now.Add(30 * time.Minute)) {
+		t.Errorf("exp 불일치: %v", claims.ExpiresAt)
+	}
+}
+
+func TestAccessTokenExpiry(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+
+	accessToken, err := jwtService.GenerateAccessToken(context.Background(), 1, "")
+	if err != nil {
+		t.Fatalf("Access Token 생성 실패: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		status  TokenStatus
+	}{
+		{"만료 전", 29 * time.Minute, TokenValid},
+		{"허용 오차 이내", 30*time.Minute + 20*time.Second, TokenValid},
+		{"허용 오차 초과", 30*time.Minute + 31*time.Second, TokenExpired},
+		{"발급 전 (허용 오차 이내)", -20 * time.Second, TokenValid},
+		{"발급 전 (허용 오차 초과)", -time.Minute, TokenInvalid},
+	}
+
+	issuedAt := now
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = issuedAt.Add(tt.elapsed)
+			if status := jwtService.VerifyStatus(context.Background(), accessToken); status != tt.status {
+				t.Errorf("토큰 상태 불일치: got %d, want %d", status, tt.status)
+			}
+		})
+	}
+}
+
+func TestIssuerAndAudienceValidation(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	otherIssuer := testJwtConfig()
+	otherIssuer.Issuer = "other-service"
+
+	otherAudience := testJwtConfig()
+	otherAudience.Audience = []string{"other-api"}
+
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+
+	for name, cfg := range map[string]*config.JWT{"iss": otherIssuer, "aud": otherAudience} {
+		t.Run(name, func(t *testing.T) {
+			token, err := NewJwtService(cfg, fixedClock(&now)).GenerateAccessToken(context.Background(), 1, "")
+			if err != nil {
+				t.Fatalf("토큰 생성 실패: %v", err)
+			}
+
+			if _, err := jwtService.VerifyAccessToken(context.Background(), token); err != ErrTokenInvalid {
+				t.Errorf("다른 %s 토큰이 허용됨: %v", name, err)
+			}
+		})
+	}
+}
+
+func TestTokenTypeAndID(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+
+	refreshToken, err := jwtService.GenerateRefreshToken(1, "session", "")
+	if err != nil {
+		t.Fatalf("Refresh Token 생성 실패: %v", err)
+	}
+
+	// refresh 토큰을 access 토큰으로 사용
+	if _, err := jwtService.VerifyAccessToken(context.Background(), refreshToken); err != ErrTokenTypeWrong {
+		t.Errorf("토큰 타입 검증 실패: %v", err)
+	}
+
+	claims, err := jwtService.VerifyRefreshToken(refreshToken)
+	if err != nil {
+		t.Fatalf("Refresh Token 검증 실패: %v", err)
+	}
+	if claims.SessionID != "session" {
+		t.Errorf("sid 불일치: %s", claims.SessionID)
+	}
+
+	// 동일 시각 발급이어도 jti는 고유
+	other, err := jwtService.GenerateRefreshToken(1, "session", "")
+	if err != nil {
+		t.Fatalf("Refresh Token 생성 실패: %v", err)
+	}
+	otherClaims, err := jwtService.VerifyRefreshToken(other)
+	if err != nil {
+		t.Fatalf("Refresh Token 검증 실패: %v", err)
+	}
+	if claims.ID == otherClaims.ID {
+		t.Error("jti 중복")
+	}
+}
+
+func TestAuthTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+	ctx := context.Background()
 
-	cfg, err := config.Load()
+	login, err := jwtService.Login(ctx, 1, "session", "", nil)
 	if err != nil {
-		log.Error("설정 파일을 불러오는데 실패했습니다", log.MapErr("error", err))
+		t.Fatalf("로그인 토큰 생성 실패: %v", err)
 	}
 
-	jwtService := NewJwtService(&cfg.JWT)
-	memberId := int64(1)
-	accessToken, err := jwtService.GenerateAccessToken(memberId)
+	refreshClaims, err := jwtService.VerifyRefreshToken(login.RefreshToken)
 	if err != nil {
-		log.Error("Access Token 생성 실패", log.MapErr("error", err))
+		t.Fatalf("Refresh Token 검증 실패: %v", err)
+	}
+	if refreshClaims.AuthTime == nil || !refreshClaims.AuthTime.Equal(now) {
+		t.Fatalf("refresh auth_time 불일치: %v", refreshClaims.AuthTime)
+	}
+
+	// 로그인 직후는 최근 인증
+	accessClaims := jwtService.Claims(ctx, login.AccessToken)
+	if !jwtService.RecentlyAuthenticated(accessClaims) {
+		t.Error("로그인 직후 최근 인증으로 판단되지 않음")
+	}
+
+	// refresh 로 재발급해도 로그인 시각 유지 → 재인증 필요
+	now = now.Add(10 * time.Minute)
+	renewed, err := jwtService.GenerateAccessTokenAt(ctx, 1, "", refreshClaims.AuthTime, nil)
+	if err != nil {
+		t.Fatalf("Access Token 재발급 실패: %v", err)
+	}
+	if jwtService.RecentlyAuthenticated(jwtService.Claims(ctx, renewed)) {
+		t.Error("재발급 토큰이 auth_time 을 갱신함")
+	}
+
+	// 재인증 토큰은 auth_time 갱신, 재인증 유효 시간만 유효
+	elevated, err := jwtService.GenerateElevatedAccessToken(ctx, 1, "", nil)
+	if err != nil {
+		t.Fatalf("재인증 토큰 생성 실패: %v", err)
+	}
+	elevatedClaims := jwtService.Claims(ctx, elevated)
+	if !jwtService.RecentlyAuthenticated(elevatedClaims) {
+		t.Error("재인증 토큰이 최근 인증으로 판단되지 않음")
+	}
+	if !elevatedClaims.ExpiresAt.Equal(now.Add(5 * time.Minute)) {
+		t.Errorf("재인증 토큰 만료 시각 불일치: %v", elevatedClaims.ExpiresAt)
+	}
+
+	now = now.Add(6 * time.Minute)
+	if jwtService.RecentlyAuthenticated(elevatedClaims) {
+		t.Error("재인증 유효 시간 경과 후에도 최근 인증으로 판단됨")
+	}
+}
+
+func TestEncryptedAccessToken(t *testing.T) {
+	for _, mode := range []string{TokenEncryptionDirect, TokenEncryptionKeyWrap} {
+		t.Run(mode, func(t *testing.T) {
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			cfg := testJwtConfig()
+			cfg.Encryption = mode
+			cfg.EncryptionSecret = []byte("ENCRYPTION_SECRET_KEY_FOR_TEST_32BYTES")
+			jwtService := NewJwtService(cfg, fixedClock(&now))
+
+			accessToken, err := jwtService.GenerateAccessToken(context.Background(), 1, "")
+			if err != nil {
+				t.Fatalf("Access Token 생성 실패: %v", err)
+			}
+
+			// JWE Compact: header.encryptedKey.iv.ciphertext.tag
+			if parts := strings.Split(accessToken, "."); len(parts) != 5 {
+				t.Fatalf("JWE Compact 형식 아님: %d 부분", len(parts))
+			}
+
+			claims, err := jwtService.VerifyAccessToken(context.Background(), accessToken)
+			if err != nil || claims.MemberID != 1 {
+				t.Fatalf("암호화 토큰 검증 실패: %v", err)
+			}
+
+			// 서명만 된 토큰은 거부
+			plain, _ := NewJwtService(testJwtConfig(), fixedClock(&now)).GenerateAccessToken(context.Background(), 1, "")
+			if _, err := jwtService.VerifyAccessToken(context.Background(), plain); err != ErrTokenInvalid {
+				t.Errorf("서명 토큰 거부 기대, 결과: %v", err)
+			}
+
+			// 다른 키로 암호화된 토큰은 거부
+			other := testJwtConfig()
+			other.Encryption = mode
+			other.EncryptionSecret = []byte("OTHER_ENCRYPTION_SECRET_KEY_32BYTES")
+			foreign, _ := NewJwtService(other, fixedClock(&now)).GenerateAccessToken(context.Background(), 1, "")
+			if _, err := jwtService.VerifyAccessToken(context.Background(), foreign); err != ErrTokenInvalid {
+				t.Errorf("다른 키 토큰 거부 기대, 결과: %v", err)
+			}
+
+			// refresh 토큰은 서명만 유지
+			refresh, _ := jwtService.GenerateRefreshToken(1, "session", "")
+			if _, err := jwtService.VerifyRefreshToken(refresh); err != nil {
+				t.Errorf("refresh 토큰 검증 실패: %v", err)
+			}
+
+			// 만료 판단은 내부 JWT 기준
+			now = now.Add(time.Hour)
+			if _, err := jwtService.VerifyAccessToken(context.Background(), accessToken); err != ErrTokenExpired {
+				t.Errorf("ErrTokenExpired 기대, 결과: %v", err)
+			}
+		})
 	}
-	log.Info("Access Token 생성 성공", log.MapStr("accessToken", accessToken))
 }
@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"study/internal/config"
+	"study/internal/shared/model"
+	"study/pkg/log"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// 기본 LDAP 응답 대기 시간
+const defaultLDAPTimeout = 5 * time.Second
+
+// LDAP 연결 (테스트에서 디렉터리 대체용)
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+type ldapDialer func(ctx context.Context) (ldapConn, error)
+
+// LDAP bind 인증
+// - 서비스 계정으로 사용자 검색 후 사용자 DN 으로 bind 하여 비밀번호 확인
+// - 그룹(memberOf 등)을 권한으로 매핑
+type LDAPAuthenticator struct {
+	cfg          *config.LDAP
+	dial         ldapDialer
+	roleMapping  map[string]model.Role // 소문자 그룹 DN → 권한
+	managedRoles []model.Role
+}
+
+func NewLDAPAuthenticator(cfg *config.LDAP) *LDAPAuthenticator {
+	a := newLDAPAuthenticator(cfg, nil)
+	a.dial = a.dialDirectory
+	return a
+}
+
+func newLDAPAuthenticator(cfg *config.LDAP, dial ldapDialer) *LDAPAuthenticator {
+	a := &LDAPAuthenticator{
+		cfg:         cfg,
+		dial:        dial,
+		roleMapping: make(map[string]model.Role, len(cfg.RoleMapping)),
+	}
+
+	for group, name := range cfg.RoleMapping {
+		role := model.Role(name)
+		if !slices.Contains(model.Roles, role) {
+			log.Warn("LDAP 권한 매핑 무시 (알 수 없는 권한)", log.MapStr("group", group), log.MapStr("role", name))
+			continue
+		}
+		a.roleMapping[strings.ToLower(group)] = role
+		if !slices.Contains(a.managedRoles, role) {
+			a.managedRoles = append(a.managedRoles, role)
+		}
+	}
+
+	return a
+}
+
+func (a *LDAPAuthenticator) Name() string {
+	return ProviderLDAP
+}
+
+func (a *LDAPAuthenticator) Authenticate(ctx context.Context, email string, password string) (*Identity, error) {
+	// 빈 비밀번호는 unauthenticated bind 로 성공 처리되므로 거부 (RFC 4513 5.1.2)
+	if email == "" || password == "" {
+		return nil, ErrInvalidCredential
+	}
+
+	conn, err := a.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// 서비스 계정으로 사용자 검색
+	if a.cfg.BindDN != "" {
+		if err = conn.Bind(a.cfg.BindDN, a.cfg.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap service bind: %w", err)
+		}
+	}
+
+	attributes := []string{a.cfg.EmailAttr}
+	for _, attr := range []string{a.cfg.NameAttr, a.cfg.GroupAttr, a.cfg.SubjectAttr} {
+		if attr != "" {
+			attributes = append(attributes, attr)
+		}
+	}
+
+	result, err := conn.Search(ldap.NewSearchRequest(
+		a.cfg.BaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2, // 중복 사용자 확인용
+		a.timeoutSec(),
+		false,
+		fmt.Sprintf(a.cfg.UserFilter, ldap.EscapeFilter(email)),
+		attributes,
+		nil,
+	))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			return nil, ErrInvalidCredential
+		}
+		return nil, fmt.Errorf("ldap search: %w", err)
+	}
+
+	// 없는 사용자 / 같은 이메일의 사용자가 여럿이면 거부
+	if len(result.Entries) != 1 {
+		return nil, ErrInvalidCredential
+	}
+	entry := result.Entries[0]
+
+	// 사용자 DN 으로 bind (비밀번호 확인)
+	if err = conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredential
+		}
+		return nil, fmt.Errorf("ldap user bind: %w", err)
+	}
+
+	identity := &Identity{
+		Provider:     ProviderLDAP,
+		Subject:      entry.DN,
+		Email:        entry.GetAttributeValue(a.cfg.EmailAttr),
+		Name:         entry.GetAttributeValue(a.cfg.NameAttr),
+		Roles:        a.mapRoles(entry.GetAttributeValues(a.cfg.GroupAttr)),
+		ManagedRoles: a.managedRoles,
+		Provision:    a.cfg.Provision,
+	}
+	if a.cfg.SubjectAttr != "" {
+		identity.Subject = entry.GetAttributeValue(a.cfg.SubjectAttr)
+	}
+	if identity.Subject == "" || identity.Email == "" {
+		return nil, errors.New("ldap entry missing subject or email attribute")
+	}
+	if identity.Name == "" {
+		identity.Name = identity.Email
+	}
+
+	return identity, nil
+}
+
+// 그룹 DN → 권한 (DN 비교는 대소문자 무시)
+func (a *LDAPAuthenticator) mapRoles(groups []string) []model.Role {
+	var roles []model.Role
+	for _, group := range groups {
+		role, ok := a.roleMapping[strings.ToLower(group)]
+		if ok && !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// 디렉터리 연결 (ldaps:// 또는 StartTLS)
+func (a *LDAPAuthenticator) dialDirectory(ctx context.Context) (ldapConn, error) {
+	timeout := a.timeout()
+
+	parsed, err := url.Parse(a.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{ServerName: parsed.Hostname(), MinVersion: tls.VersionTLS12}
+
+	conn, err := ldap.DialURL(a.cfg.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(timeout)
+
+	if a.cfg.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+func (a *LDAPAuthenticator) timeout() time.Duration {
+	if a.cfg.TimeoutSec <= 0 {
+		return defaultLDAPTimeout
+	}
+	return time.Duration(a.cfg.TimeoutSec) * time.Second
+}
+
+func (a *LDAPAuthenticator) timeoutSec() int {
+	return int(a.timeout() / time.Second)
+}
@@ -0,0 +1,222 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"study/internal/config"
+	"study/internal/shared/model"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// 메모리 LDAP 디렉터리 (bind / 이메일 검색만 지원)
+type fakeDirectory struct {
+	cfg             *config.LDAP
+	servicePassword string
+	entries         []fakeEntry
+	dials           int
+}
+
+type fakeEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+type fakeConn struct {
+	dir *fakeDirectory
+}
+
+func (d *fakeDirectory) dial(ctx context.Context) (ldapConn, error) {
+	d.dials++
+	return &fakeConn{dir: d}, nil
+}
+
+func (c *fakeConn) Bind(username, password string) error {
+	if username == c.dir.cfg.BindDN && password == c.dir.servicePassword {
+		return nil
+	}
+	for _, entry := range c.dir.entries {
+		if entry.dn == username && entry.password == password {
+			return nil
+		}
+	}
+	return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+}
+
+// 설정 filter 에 항목 이메일을 넣은 결과와 같으면 일치
+func (c *fakeConn) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	result := &ldap.SearchResult{}
+	for _, entry := range c.dir.entries {
+		for _, mail := range entry.attrs[c.dir.cfg.EmailAttr] {
+			if req.Filter == fmt.Sprintf(c.dir.cfg.UserFilter, ldap.EscapeFilter(mail)) {
+				result.Entries = append(result.Entries, ldap.NewEntry(entry.dn, entry.attrs))
+			}
+		}
+	}
+	return result, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func testLDAPConfig() *config.LDAP {
+	return &config.LDAP{
+		URL:          "ldap://localhost:389",
+		BindDN:       "cn=readonly,dc=example,dc=com",
+		BindPassword: "readonly",
+		BaseDN:       "ou=people,dc=example,dc=com",
+		UserFilter:   "(&(objectClass=inetOrgPerson)(mail=%s))",
+		SubjectAttr:  "entryUUID",
+		EmailAttr:    "mail",
+		NameAttr:     "cn",
+		GroupAttr:    "memberOf",
+		RoleMapping: map[string]string{
+			"cn=admins,ou=groups,dc=example,dc=com":  "ADMIN",
+			"cn=unknown,ou=groups,dc=example,dc=com": "ROOT",
+		},
+		Provision: true,
+	}
+}
+
+func testDirectory(cfg *config.LDAP) *fakeDirectory {
+	return &fakeDirectory{cfg: cfg, servicePassword: cfg.BindPassword, entries: []fakeEntry{
+		{
+			dn:       "uid=kim,ou=people,dc=example,dc=com",
+			password: "kim-password",
+			attrs: map[string][]string{
+				"entryUUID": {"uuid-kim"},
+				"mail":      {"kim@example.com"},
+				"cn":        {"Kim"},
+				"memberOf":  {"CN=Admins,OU=Groups,DC=example,DC=com", "cn=staff,ou=groups,dc=example,dc=com"},
+			},
+		},
+		{
+			dn:       "uid=lee,ou=people,dc=example,dc=com",
+			password: "lee-password",
+			attrs: map[string][]string{
+				"entryUUID": {"uuid-lee"},
+				"mail":      {"lee@example.com"},
+			},
+		},
+	}}
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	cfg := testLDAPConfig()
+	directory := testDirectory(cfg)
+	authenticator := newLDAPAuthenticator(cfg, directory.dial)
+
+	identity, err := authenticator.Authenticate(context.Background(), "kim@example.com", "kim-password")
+	if err != nil {
+		t.Fatalf("LDAP 인증 실패: %v", err)
+	}
+
+	if identity.Provider != ProviderLDAP || identity.Subject != "uuid-kim" || identity.Email != "kim@example.com" || identity.Name != "Kim" {
+		t.Errorf("LDAP 인증 결과 불일치: %+v", identity)
+	}
+	if !slices.Equal(identity.Roles, []model.Role{model.RoleAdmin}) {
+		t.Errorf("그룹 권한 매핑 불일치: %v", identity.Roles)
+	}
+	if !slices.Equal(identity.ManagedRoles, []model.Role{model.RoleAdmin}) {
+		t.Errorf("동기화 대상 권한 불일치 (알 수 없는 권한 포함): %v", identity.ManagedRoles)
+	}
+	if !identity.Provision {
+		t.Error("자동 생성 설정 미반영")
+	}
+
+	// 그룹 / 이름이 없는 사용자
+	identity, err = authenticator.Authenticate(context.Background(), "lee@example.com", "lee-password")
+	if err != nil {
+		t.Fatalf("LDAP 인증 실패: %v", err)
+	}
+	if len(identity.Roles) != 0 || identity.Name != "lee@example.com" {
+		t.Errorf("기본값 처리 불일치: %+v", identity)
+	}
+}
+
+func TestLDAPAuthenticateRejected(t *testing.T) {
+	cfg := testLDAPConfig()
+	directory := testDirectory(cfg)
+	authenticator := newLDAPAuthenticator(cfg, directory.dial)
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+	}{
+		{"비밀번호 불일치", "kim@example.com", "wrong"},
+		{"없는 사용자", "park@example.com", "kim-password"},
+		{"filter 주입", "*", "kim-password"},
+		{"빈 비밀번호 (unauthenticated bind)", "kim@example.com", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := authenticator.Authenticate(context.Background(), tt.email, tt.password); err != ErrInvalidCredential {
+				t.Errorf("ErrInvalidCredential 기대, 결과: %v", err)
+			}
+		})
+	}
+
+	if directory.dials != len(tests)-1 {
+		t.Errorf("빈 비밀번호는 디렉터리 연결 전에 거부해야 함 (연결 %d회)", directory.dials)
+	}
+
+	// 서비스 계정 오류는 자격 증명 거부가 아님
+	cfg.BindPassword = "wrong"
+	if _, err := authenticator.Authenticate(context.Background(), "kim@example.com", "kim-password"); err == nil || err == ErrInvalidCredential {
+		t.Errorf("서비스 계정 bind 실패가 장애로 처리되지 않음: %v", err)
+	}
+}
+
+// 고정 결과 인증 제공자
+type stubAuthenticator struct {
+	identity *Identity
+	err      error
+}
+
+func (a *stubAuthenticator) Name() string {
+	return "stub"
+}
+
+func (a *stubAuthenticator) Authenticate(ctx context.Context, email string, password string) (*Identity, error) {
+	return a.identity, a.err
+}
+
+func TestAuthenticatorChain(t *testing.T) {
+	cfg := testLDAPConfig()
+	ldapAuthenticator := newLDAPAuthenticator(cfg, testDirectory(cfg).dial)
+	rejected := &stubAuthenticator{err: ErrInvalidCredential}
+	unavailable := &stubAuthenticator{err: errors.New("connection refused")}
+
+	// 앞 제공자가 거부하면 다음 제공자로 진행
+	chain := NewAuthenticatorChain(rejected, ldapAuthenticator)
+	identity, err := chain.Authenticate(context.Background(), "kim@example.com", "kim-password")
+	if err != nil || identity.Provider != ProviderLDAP {
+		t.Fatalf("체인 인증 실패: %v", err)
+	}
+
+	// 장애 제공자가 있어도 다음 제공자 결과 사용
+	chain = NewAuthenticatorChain(unavailable, ldapAuthenticator)
+	if _, err = chain.Authenticate(context.Background(), "kim@example.com", "kim-password"); err != nil {
+		t.Fatalf("장애 제공자 이후 인증 실패: %v", err)
+	}
+
+	// 모두 거부
+	chain = NewAuthenticatorChain(rejected, ldapAuthenticator)
+	if _, err = chain.Authenticate(context.Background(), "kim@example.com", "wrong"); err != ErrInvalidCredential {
+		t.Errorf("ErrInvalidCredential 기대, 결과: %v", err)
+	}
+
+	// 장애로 확인할 수 없으면 자격 증명 거부와 구분
+	chain = NewAuthenticatorChain(unavailable, rejected)
+	if _, err = chain.Authenticate(context.Background(), "kim@example.com", "kim-password"); err != ErrAuthenticatorUnavailable {
+		t.Errorf("ErrAuthenticatorUnavailable 기대, 결과: %v", err)
+	}
+}
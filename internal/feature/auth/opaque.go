@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"study/internal/query"
+	"study/pkg/log"
+	"study/pkg/util"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// access 토큰 발급 방식
+const (
+	AccessTokenModeJWT    = "jwt"
+	AccessTokenModeOpaque = "opaque"
+)
+
+// 조회 결과 캐시 유지 시간 (폐기 반영 지연 상한)
+const opaqueCacheTTL = 30 * time.Second
+
+// 만료 토큰 삭제 주기
+const opaquePurgeInterval = time.Hour
+
+// 불투명 토큰 쿼리 (query.Queries)
+type opaqueQueries interface {
+	CreateAccessToken(ctx context.Context, arg query.CreateAccessTokenParams) error
+	FindAccessTokenClaims(ctx context.Context, tokenHash string) ([]byte, error)
+	RevokeAccessToken(ctx context.Context, tokenHash string) error
+	RevokeAccessTokensByMemberID(ctx context.Context, memberID int64) error
+	DeleteExpiredAccessTokens(ctx context.Context) error
+}
+
+// 불투명(reference) access 토큰 저장소
+// - 원문은 저장하지 않고 SHA-256 해시로 조회
+// - Postgres 를 원본으로, 인스턴스 메모리에 짧게 캐시
+type OpaqueTokenStore struct {
+	queries opaqueQueries
+
+	mu        sync.RWMutex
+	cache     map[string]opaqueCacheEntry
+	lastPurge time.Time
+}
+
+type opaqueCacheEntry struct {
+	claims      *Claims
+	cachedUntil time.Time
+}
+
+func NewOpaqueTokenStore(queries *query.Queries) *OpaqueTokenStore {
+	return &OpaqueTokenStore{
+		queries: queries,
+		cache:   make(map[string]opaqueCacheEntry),
+	}
+}
+
+// Claims 저장 후 불투명 토큰 반환
+func (s *OpaqueTokenStore) Issue(ctx context.Context, claims *Claims, expireMin int) (string, error) {
+	token, err := util.RandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.queries.CreateAccessToken(ctx, query.CreateAccessTokenParams{
+		TokenHash: hashOpaqueToken(token),
+		MemberID:  claims.MemberID,
+		Claims:    data,
+		ExpireMin: int32(expireMin),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// 토큰으로 Claims 조회 (없거나 폐기된 토큰은 ErrTokenInvalid, 만료는 호출측에서 판단)
+func (s *OpaqueTokenStore) Lookup(ctx context.Context, token string) (*Claims, error) {
+	key := hashOpaqueToken(token)
+	now := time.Now()
+
+	s.mu.RLock()
+	entry, ok := s.cache[key]
+	s.mu.RUnlock()
+	if ok && now.Before(entry.cachedUntil) {
+		return entry.claims, nil
+	}
+
+	data, err := s.queries.FindAccessTokenClaims(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTokenInvalid
+		}
+		return nil, err
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	s.store(key, &claims, now)
+	return &claims, nil
+}
+
+// 토큰 폐기
+func (s *OpaqueTokenStore) Revoke(ctx context.Context, token string) error {
+	key := hashOpaqueToken(token)
+
+	s.mu.Lock()
+	delete(s.cache, key)
+	s.mu.Unlock()
+
+	return s.queries.RevokeAccessToken(ctx, key)
+}
+
+// 회원의 모든 토큰 폐기 (다른 인스턴스 캐시는 TTL 이후 반영)
+func (s *OpaqueTokenStore) RevokeAll(ctx context.Context, memberID int64) error {
+	s.mu.Lock()
+	for key, entry := range s.cache {
+		if entry.claims.MemberID == memberID {
+			delete(s.cache, key)
+		}
+	}
+	s.mu.Unlock()
+
+	return s.queries.RevokeAccessTokensByMemberID(ctx, memberID)
+}
+
+// 만료 토큰 삭제
+func (s *OpaqueTokenStore) Purge(ctx context.Context) error {
+	return s.queries.DeleteExpiredAccessTokens(ctx)
+}
+
+// 주기적으로 만료 토큰 삭제 (ctx 종료 시 중단)
+func (s *OpaqueTokenStore) RunPurge(ctx context.Context) {
+	ticker := time.NewTicker(opaquePurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Purge(ctx); err != nil {
+				log.ErrorCtx(ctx, "만료 access 토큰 삭제 실패", log.MapErr("error", err))
+			}
+		}
+	}
+}
+
+// 캐시 저장 (토큰 만료 이후로는 캐시하지 않음)
+func (s *OpaqueTokenStore) store(key string, claims *Claims, now time.Time) {
+	cachedUntil := now.Add(opaqueCacheTTL)
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(cachedUntil) {
+		cachedUntil = claims.ExpiresAt.Time
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 만료 항목 정리 (캐시 유지 시간마다 한 번)
+	if now.Sub(s.lastPurge) > opaqueCacheTTL {
+		for k, entry := range s.cache {
+			if now.After(entry.cachedUntil) {
+				delete(s.cache, k)
+			}
+		}
+		s.lastPurge = now
+	}
+
+	s.cache[key] = opaqueCacheEntry{claims: claims, cachedUntil: cachedUntil}
+}
+
+func hashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"study/internal/query"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// 메모리 불투명 토큰 저장소 (만료 삭제는 expired 표시로 판단)
+type memoryAccessTokens struct {
+	tokens map[string]*memoryAccessToken
+}
+
+type memoryAccessToken struct {
+	memberID int64
+	claims   []byte
+	revoked  bool
+	expired  bool
+}
+
+func (m *memoryAccessTokens) CreateAccessToken(ctx context.Context, arg query.CreateAccessTokenParams) error {
+	m.tokens[arg.TokenHash] = &memoryAccessToken{memberID: arg.MemberID, claims: arg.Claims}
+	return nil
+}
+
+func (m *memoryAccessTokens) FindAccessTokenClaims(ctx context.Context, tokenHash string) ([]byte, error) {
+	token, ok := m.tokens[tokenHash]
+	if !ok || token.revoked {
+		return nil, pgx.ErrNoRows
+	}
+	return token.claims, nil
+}
+
+func (m *memoryAccessTokens) RevokeAccessToken(ctx context.Context, tokenHash string) error {
+	if token, ok := m.tokens[tokenHash]; ok {
+		token.revoked = true
+	}
+	return nil
+}
+
+func (m *memoryAccessTokens) RevokeAccessTokensByMemberID(ctx context.Context, memberID int64) error {
+	for _, token := range m.tokens {
+		if token.memberID == memberID {
+			token.revoked = true
+		}
+	}
+	return nil
+}
+
+func (m *memoryAccessTokens) DeleteExpiredAccessTokens(ctx context.Context) error {
+	for hash, token := range m.tokens {
+		if token.expired {
+			delete(m.tokens, hash)
+		}
+	}
+	return nil
+}
+
+func newOpaqueJwtService(now *time.Time) (*JwtService, *memoryAccessTokens) {
+	tokens := &memoryAccessTokens{tokens: map[string]*memoryAccessToken{}}
+	store := NewOpaqueTokenStore(nil)
+	store.queries = tokens
+
+	cfg := testJwtConfig()
+	cfg.AccessTokenMode = AccessTokenModeOpaque
+	return NewJwtService(cfg, fixedClock(now), WithOpaqueStore(store)), tokens
+}
+
+func TestOpaqueAccessToken(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService, tokens := newOpaqueJwtService(&now)
+	ctx := context.Background()
+
+	token, err := jwtService.GenerateAccessToken(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("불투명 토큰 발급 실패: %v", err)
+	}
+
+	// 원문이 아닌 해시로 저장
+	if _, ok := tokens.tokens[token]; ok || len(tokens.tokens) != 1 {
+		t.Fatalf("토큰 원문 저장 또는 저장 누락: %d", len(tokens.tokens))
+	}
+
+	claims, err := jwtService.VerifyAccessToken(ctx, token)
+	if err != nil || claims.MemberID != 1 {
+		t.Fatalf("불투명 토큰 검증 실패: %v", err)
+	}
+
+	// 인트로스펙션
+	service := &AuthService{JwtService: jwtService}
+	if resp := service.Introspect(ctx, token); !resp.Active || resp.Sub != "1" {
+		t.Errorf("인트로스펙션 결과 불일치: %+v", resp)
+	}
+	if resp := service.Introspect(ctx, "unknown"); resp.Active {
+		t.Error("알 수 없는 토큰이 활성으로 판단됨")
+	}
+
+	// 만료 (저장소에 남아 있어도 시계 기준 만료)
+	now = now.Add(time.Hour)
+	if _, err := jwtService.VerifyAccessToken(ctx, token); err != ErrTokenExpired {
+		t.Errorf("만료 토큰 허용: %v", err)
+	}
+}
+
+func TestOpaqueAccessTokenRevoke(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService, tokens := newOpaqueJwtService(&now)
+	ctx := context.Background()
+
+	first, _ := jwtService.GenerateAccessToken(ctx, 1, "")
+	second, _ := jwtService.GenerateAccessToken(ctx, 1, "")
+	other, _ := jwtService.GenerateAccessToken(ctx, 2, "")
+
+	// 캐시에 올린 뒤 폐기해도 즉시 반영
+	for _, token := range []string{first, second, other} {
+		if _, err := jwtService.VerifyAccessToken(ctx, token); err != nil {
+			t.Fatalf("불투명 토큰 검증 실패: %v", err)
+		}
+	}
+
+	// 로그아웃: 해당 토큰만 폐기
+	if err := jwtService.RevokeAccessToken(ctx, first); err != nil {
+		t.Fatalf("토큰 폐기 실패: %v", err)
+	}
+	if _, err := jwtService.VerifyAccessToken(ctx, first); err != ErrTokenInvalid {
+		t.Errorf("폐기된 토큰 허용: %v", err)
+	}
+	if _, err := jwtService.VerifyAccessToken(ctx, second); err != nil {
+		t.Errorf("다른 토큰까지 폐기됨: %v", err)
+	}
+
+	// 비밀번호 변경 / 모든 기기 로그아웃: 회원 토큰 전체 폐기
+	if err := jwtService.RevokeAccessTokens(ctx, 1); err != nil {
+		t.Fatalf("전체 토큰 폐기 실패: %v", err)
+	}
+	if _, err := jwtService.VerifyAccessToken(ctx, second); err != ErrTokenInvalid {
+		t.Errorf("전체 폐기 후 토큰 허용: %v", err)
+	}
+	if _, err := jwtService.VerifyAccessToken(ctx, other); err != nil {
+		t.Errorf("다른 회원 토큰까지 폐기됨: %v", err)
+	}
+
+	// 만료 토큰 정리
+	for _, token := range tokens.tokens {
+		token.expired = token.revoked
+	}
+	if err := jwtService.opaque.Purge(ctx); err != nil {
+		t.Fatalf("만료 토큰 삭제 실패: %v", err)
+	}
+	if len(tokens.tokens) != 1 {
+		t.Errorf("만료 토큰 삭제 후 남은 개수: %d, 기대: 1", len(tokens.tokens))
+	}
+}
+
+func TestRevokeAccessTokenJWTMode(t *testing.T) {
+	jwtService := NewJwtService(testJwtConfig())
+	ctx := context.Background()
+
+	token, err := jwtService.GenerateAccessToken(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("access 토큰 생성 실패: %v", err)
+	}
+
+	// JWT 모드는 저장소가 없으므로 폐기 요청을 무시
+	if err := jwtService.RevokeAccessToken(ctx, token); err != nil {
+		t.Errorf("JWT 모드 폐기 요청 실패: %v", err)
+	}
+	if err := jwtService.RevokeAccessTokens(ctx, 1); err != nil {
+		t.Errorf("JWT 모드 전체 폐기 요청 실패: %v", err)
+	}
+}
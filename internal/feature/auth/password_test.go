@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"study/internal/config"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/pkg/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestIsPasswordExpired(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	changedAt := func(days int, offset time.Duration) query.Member {
+		return query.Member{PasswordChangedAt: pgtype.Timestamp{Time: now.AddDate(0, 0, -days).Add(offset), Valid: true}}
+	}
+
+	tests := []struct {
+		name   string
+		policy *config.Password
+		member query.Member
+		roles  []model.Role
+		want   bool
+	}{
+		{"정책 없음", nil, changedAt(365, 0), []model.Role{model.RoleUser}, false},
+		{"만료 주기 미설정", &config.Password{}, changedAt(365, 0), []model.Role{model.RoleUser}, false},
+		{"주기 이내", &config.Password{ExpireDays: 90}, changedAt(30, 0), []model.Role{model.RoleUser}, false},
+		{"만료 1초 전", &config.Password{ExpireDays: 90}, changedAt(90, time.Second), []model.Role{model.RoleUser}, false},
+		{"만료 시각", &config.Password{ExpireDays: 90}, changedAt(90, 0), []model.Role{model.RoleUser}, false},
+		{"만료 1초 후", &config.Password{ExpireDays: 90}, changedAt(90, -time.Second), []model.Role{model.RoleUser}, true},
+		{"대상 권한 보유", &config.Password{ExpireDays: 90, ExpireRoles: []string{"ADMIN"}}, changedAt(91, 0), []model.Role{model.RoleUser, model.RoleAdmin}, true},
+		{"대상 권한 아님", &config.Password{ExpireDays: 90, ExpireRoles: []string{"ADMIN"}}, changedAt(91, 0), []model.Role{model.RoleUser}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &AuthService{passwordPolicy: tt.policy, now: func() time.Time { return now }}
+			if got := s.isPasswordExpired(tt.member, tt.roles); got != tt.want {
+				t.Errorf("isPasswordExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesAnyPassword(t *testing.T) {
+	var hashes []string
+	for _, password := range []string{"old-password-1", "old-password-2"} {
+		hashed, err := util.HashString(password)
+		if err != nil {
+			t.Fatalf("해시 생성 실패: %v", err)
+		}
+		hashes = append(hashes, hashed)
+	}
+
+	if !matchesAnyPassword("old-password-2", hashes) {
+		t.Error("이전 비밀번호 재사용이 허용됨")
+	}
+	if matchesAnyPassword("new-password", hashes) {
+		t.Error("새 비밀번호가 재사용으로 판단됨")
+	}
+	if matchesAnyPassword("old-password-1", nil) {
+		t.Error("이력 없이 재사용으로 판단됨")
+	}
+}
+
+func TestPasswordChangeToken(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtService := NewJwtService(testJwtConfig(), fixedClock(&now))
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		jkt  string
+	}{
+		{"Bearer", ""},
+		{"DPoP 바인딩", "thumbprint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := jwtService.GeneratePasswordChangeToken(ctx, 1, tt.jkt)
+			if err != nil {
+				t.Fatalf("비밀번호 변경 토큰 생성 실패: %v", err)
+			}
+
+			claims := jwtService.Claims(ctx, token)
+			if claims == nil {
+				t.Fatal("비밀번호 변경 토큰 검증 실패")
+			}
+			if claims.Scope != ScopePasswordChange {
+				t.Errorf("scope 불일치: %q", claims.Scope)
+			}
+			if claims.BoundKey() != tt.jkt {
+				t.Errorf("cnf.jkt 불일치: %q, 기대: %q", claims.BoundKey(), tt.jkt)
+			}
+			if !jwtService.RecentlyAuthenticated(claims) {
+				t.Error("비밀번호 변경 토큰이 최근 인증으로 판단되지 않음")
+			}
+		})
+	}
+}
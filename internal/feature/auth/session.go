@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"study/internal/config"
+	"study/internal/query"
+	"study/pkg/log"
+	"study/pkg/util"
+	"time"
+)
+
+// 폐기 / 만료 세션 삭제 주기
+const sessionPurgeInterval = time.Hour
+
+// 리프레쉬 세션 저장소 (query.Queries)
+type sessionStore interface {
+	CreateRefreshSession(ctx context.Context, arg query.CreateRefreshSessionParams) error
+	TouchRefreshSession(ctx context.Context, arg query.TouchRefreshSessionParams) (int64, error)
+	RevokeRefreshSession(ctx context.Context, sessionID string) error
+	RevokeRefreshSessionsByMemberID(ctx context.Context, memberID int64) error
+	DeleteExpiredRefreshSessions(ctx context.Context) (int64, error)
+}
+
+// 리프레쉬 세션 관리
+// - 절대 만료: RefreshExpireDay (로그인 시점 기준, 연장 불가)
+// - 유휴 만료: RefreshIdleMin (Refresh 성공 시마다 연장)
+type SessionService struct {
+	queries          sessionStore
+	refreshExpireDay int
+	refreshIdleMin   int
+}
+
+func NewSessionService(queries *query.Queries, cfg *config.JWT) *SessionService {
+	return &SessionService{
+		queries:          queries,
+		refreshExpireDay: cfg.RefreshExpireDay,
+		refreshIdleMin:   cfg.RefreshIdleMin,
+	}
+}
+
+// 트랜젝션 쿼리로 동작하는 세션 서비스 (다른 변경과 함께 커밋)
+func (s *SessionService) WithQueries(queries *query.Queries) *SessionService {
+	return &SessionService{
+		queries:          queries,
+		refreshExpireDay: s.refreshExpireDay,
+		refreshIdleMin:   s.refreshIdleMin,
+	}
+}
+
+// 세션 생성 후 세션 ID 반환
+func (s *SessionService) Create(ctx context.Context, memberID int64) (string, error) {
+	sessionID, err := util.RandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.queries.CreateRefreshSession(ctx, query.CreateRefreshSessionParams{
+		SessionID: sessionID,
+		MemberID:  memberID,
+		IdleMin:   s.idleMin(),
+		ExpireDay: int32(s.refreshExpireDay),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return sessionID, nil
+}
+
+// 세션 유효성 확인 및 유휴 만료 연장
+// 폐기, 유휴 만료, 절대 만료된 세션은 ErrSessionExpired
+func (s *SessionService) Touch(ctx context.Context, sessionID string, memberID int64) error {
+	if sessionID == "" {
+		return ErrSessionExpired
+	}
+
+	affected, err := s.queries.TouchRefreshSession(ctx, query.TouchRefreshSessionParams{
+		IdleMin:   s.idleMin(),
+		SessionID: sessionID,
+		MemberID:  memberID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrSessionExpired
+	}
+
+	return nil
+}
+
+// 세션 폐기 (로그아웃)
+func (s *SessionService) Revoke(ctx context.Context, sessionID string) error {
+	return s.queries.RevokeRefreshSession(ctx, sessionID)
+}
+
+// 회원의 모든 세션 폐기 (비밀번호 변경, 모든 기기 로그아웃)
+func (s *SessionService) RevokeAll(ctx context.Context, memberID int64) error {
+	return s.queries.RevokeRefreshSessionsByMemberID(ctx, memberID)
+}
+
+// 폐기 / 만료 세션 삭제 후 삭제 건수 반환
+func (s *SessionService) Purge(ctx context.Context) (int64, error) {
+	return s.queries.DeleteExpiredRefreshSessions(ctx)
+}
+
+// 기동 시 한 번, 이후 주기적으로 폐기 / 만료 세션 삭제 (ctx 종료 시 중단)
+func (s *SessionService) RunPurge(ctx context.Context) {
+	ticker := time.NewTicker(sessionPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.Purge(ctx)
+		if err != nil {
+			log.ErrorCtx(ctx, "만료 리프레쉬 세션 삭제 실패", log.MapErr("error", err))
+		} else if deleted > 0 {
+			log.InfoCtx(ctx, "만료 리프레쉬 세션 삭제", log.MapInt64("deleted", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 유휴 만료 미설정 시 절대 만료까지 연장
+func (s *SessionService) idleMin() int32 {
+	if s.refreshIdleMin <= 0 {
+		return int32(s.refreshExpireDay * 24 * 60)
+	}
+	return int32(s.refreshIdleMin)
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"study/internal/config"
+	"study/internal/query"
+)
+
+// 메모리 세션 저장소 (유휴 / 절대 만료는 분 단위 시계로 계산)
+type memorySessions struct {
+	now      int32
+	sessions map[string]*memorySession
+}
+
+type memorySession struct {
+	memberID int64
+	idleAt   int32
+	expireAt int32
+	revoked  bool
+}
+
+func newMemorySessions() *memorySessions {
+	return &memorySessions{sessions: map[string]*memorySession{}}
+}
+
+func (m *memorySessions) CreateRefreshSession(ctx context.Context, arg query.CreateRefreshSessionParams) error {
+	m.sessions[arg.SessionID] = &memorySession{
+		memberID: arg.MemberID,
+		idleAt:   m.now + arg.IdleMin,
+		expireAt: m.now + arg.ExpireDay*24*60,
+	}
+	return nil
+}
+
+func (m *memorySessions) TouchRefreshSession(ctx context.Context, arg query.TouchRefreshSessionParams) (int64, error) {
+	session, ok := m.sessions[arg.SessionID]
+	if !ok || session.memberID != arg.MemberID || session.revoked || session.idleAt <= m.now || session.expireAt <= m.now {
+		return 0, nil
+	}
+	session.idleAt = min(m.now+arg.IdleMin, session.expireAt)
+	return 1, nil
+}
+
+func (m *memorySessions) RevokeRefreshSession(ctx context.Context, sessionID string) error {
+	if session, ok := m.sessions[sessionID]; ok {
+		session.revoked = true
+	}
+	return nil
+}
+
+func (m *memorySessions) RevokeRefreshSessionsByMemberID(ctx context.Context, memberID int64) error {
+	for _, session := range m.sessions {
+		if session.memberID == memberID {
+			session.revoked = true
+		}
+	}
+	return nil
+}
+
+func (m *memorySessions) DeleteExpiredRefreshSessions(ctx context.Context) (int64, error) {
+	var deleted int64
+	for id, session := range m.sessions {
+		if session.revoked || session.idleAt < m.now || session.expireAt < m.now {
+			delete(m.sessions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func newTestSessionService(store sessionStore, expireDay int, idleMin int) *SessionService {
+	s := NewSessionService(nil, &config.JWT{RefreshExpireDay: expireDay, RefreshIdleMin: idleMin})
+	s.queries = store
+	return s
+}
+
+func TestSessionIdleTimeout(t *testing.T) {
+	store := newMemorySessions()
+	sessions := newTestSessionService(store, 1, 30)
+	ctx := context.Background()
+
+	sessionID, err := sessions.Create(ctx, 1)
+	if err != nil {
+		t.Fatalf("세션 생성 실패: %v", err)
+	}
+
+	// 유휴 시간 이내 사용 시 연장
+	store.now = 20
+	if err := sessions.Touch(ctx, sessionID, 1); err != nil {
+		t.Fatalf("유휴 시간 이내 사용 거부: %v", err)
+	}
+	store.now = 45
+	if err := sessions.Touch(ctx, sessionID, 1); err != nil {
+		t.Fatalf("연장된 유휴 시간 이내 사용 거부: %v", err)
+	}
+
+	// 다른 회원의 세션 사용 불가
+	if err := sessions.Touch(ctx, sessionID, 2); err != ErrSessionExpired {
+		t.Errorf("다른 회원 세션 허용: %v", err)
+	}
+
+	// 유휴 시간 초과
+	store.now = 80
+	if err := sessions.Touch(ctx, sessionID, 1); err != ErrSessionExpired {
+		t.Errorf("유휴 만료 세션 허용: %v", err)
+	}
+
+	if err := sessions.Touch(ctx, "", 1); err != ErrSessionExpired {
+		t.Errorf("세션 ID 없는 토큰 허용: %v", err)
+	}
+}
+
+func TestSessionAbsoluteExpiry(t *testing.T) {
+	store := newMemorySessions()
+	sessions := newTestSessionService(store, 1, 0)
+	ctx := context.Background()
+
+	// 유휴 만료 미설정 시 절대 만료까지 유효
+	if got := sessions.idleMin(); got != 24*60 {
+		t.Errorf("idleMin() = %d, want %d", got, 24*60)
+	}
+
+	sessionID, err := sessions.Create(ctx, 1)
+	if err != nil {
+		t.Fatalf("세션 생성 실패: %v", err)
+	}
+
+	store.now = 24*60 - 1
+	if err := sessions.Touch(ctx, sessionID, 1); err != nil {
+		t.Fatalf("절대 만료 전 사용 거부: %v", err)
+	}
+
+	// 사용해도 절대 만료는 연장되지 않음
+	store.now = 24 * 60
+	if err := sessions.Touch(ctx, sessionID, 1); err != ErrSessionExpired {
+		t.Errorf("절대 만료 세션 허용: %v", err)
+	}
+}
+
+func TestSessionRevoke(t *testing.T) {
+	store := newMemorySessions()
+	sessions := newTestSessionService(store, 14, 60)
+	ctx := context.Background()
+
+	first, _ := sessions.Create(ctx, 1)
+	second, _ := sessions.Create(ctx, 1)
+	other, _ := sessions.Create(ctx, 2)
+
+	// 로그아웃: 해당 세션만 폐기
+	if err := sessions.Revoke(ctx, first); err != nil {
+		t.Fatalf("세션 폐기 실패: %v", err)
+	}
+	if err := sessions.Touch(ctx, first, 1); err != ErrSessionExpired {
+		t.Errorf("폐기된 세션 허용: %v", err)
+	}
+	if err := sessions.Touch(ctx, second, 1); err != nil {
+		t.Errorf("다른 세션까지 폐기됨: %v", err)
+	}
+
+	// 비밀번호 변경 / 모든 기기 로그아웃: 회원의 세션 전체 폐기
+	if err := sessions.RevokeAll(ctx, 1); err != nil {
+		t.Fatalf("전체 세션 폐기 실패: %v", err)
+	}
+	if err := sessions.Touch(ctx, second, 1); err != ErrSessionExpired {
+		t.Errorf("전체 폐기 후 세션 허용: %v", err)
+	}
+	if err := sessions.Touch(ctx, other, 2); err != nil {
+		t.Errorf("다른 회원 세션까지 폐기됨: %v", err)
+	}
+}
+
+func TestSessionPurge(t *testing.T) {
+	store := newMemorySessions()
+	sessions := newTestSessionService(store, 14, 60)
+	ctx := context.Background()
+
+	revoked, _ := sessions.Create(ctx, 1)
+	idle, _ := sessions.Create(ctx, 1)
+	store.now = 30
+	active, _ := sessions.Create(ctx, 1)
+	sessions.Revoke(ctx, revoked)
+
+	// 유휴 만료(60분) 지난 세션, 폐기된 세션만 삭제
+	store.now = 61
+	deleted, err := sessions.Purge(ctx)
+	if err != nil {
+		t.Fatalf("세션 삭제 실패: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("삭제 건수 불일치: %d, 기대: 2", deleted)
+	}
+	if _, ok := store.sessions[idle]; ok {
+		t.Error("유휴 만료 세션이 남음")
+	}
+	if err := sessions.Touch(ctx, active, 1); err != nil {
+		t.Errorf("유효 세션까지 삭제됨: %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+package lifecycle
+
+import (
+	"time"
+
+	"study/internal/shared/model"
+)
+
+// 상태 변경 이력 응답 DTO
+type HistoryResponse struct {
+	ID        int64        `json:"id"`
+	From      model.Status `json:"from"`
+	To        model.Status `json:"to"`
+	Reason    string       `json:"reason"`
+	Note      *string      `json:"note"`
+	ActorType ActorType    `json:"actorType"`
+	ActorID   *string      `json:"actorId"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
@@ -0,0 +1,21 @@
+package lifecycle
+
+import "errors"
+
+// 서비스 에러
+var (
+	// 회원 없음
+	ErrMemberNotFound = errors.New("MEMBER_NOT_FOUND")
+
+	// 허용되지 않는 상태 전이 (예: DELETED → ACTIVE)
+	ErrInvalidTransition = errors.New("INVALID_STATUS_TRANSITION")
+
+	// 현재 상태가 요청의 From 과 다름 (다른 요청이 먼저 변경)
+	ErrUnexpectedStatus = errors.New("STATUS_CONFLICT")
+
+	// 사유 코드 누락 / 메모 길이 초과
+	ErrInvalidReason = errors.New("INVALID_STATUS_REASON")
+
+	// 알 수 없는 변경 주체
+	ErrInvalidActor = errors.New("INVALID_STATUS_ACTOR")
+)
@@ -0,0 +1,74 @@
+package lifecycle
+
+import (
+	"context"
+
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/pkg/log"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 상태 변경 훅
+// - 상태 변경과 같은 트랜잭션에서 실행 (q 는 트랜잭션 쿼리)
+// - 에러를 반환하면 상태 변경 전체가 롤백됨
+type Hook func(ctx context.Context, q *query.Queries, change *Change) error
+
+type hook struct {
+	name  string
+	match func(from model.Status, to model.Status) bool
+	run   Hook
+}
+
+// 특정 상태를 벗어날 때 실행 (예: ACTIVE → DISABLED / DELETED)
+func (s *LifecycleService) OnLeave(status model.Status, name string, h Hook) {
+	s.hooks = append(s.hooks, hook{
+		name:  name,
+		match: func(from model.Status, _ model.Status) bool { return from == status },
+		run:   h,
+	})
+}
+
+// 특정 상태로 들어갈 때 실행 (예: READY → ACTIVE)
+func (s *LifecycleService) OnEnter(status model.Status, name string, h Hook) {
+	s.hooks = append(s.hooks, hook{
+		name:  name,
+		match: func(_ model.Status, to model.Status) bool { return to == status },
+		run:   h,
+	})
+}
+
+// 훅 실행 (등록 순서대로, 첫 실패에서 중단)
+func (s *LifecycleService) runHooks(ctx context.Context, q *query.Queries, change *Change) error {
+	for _, h := range s.hooks {
+		if !h.match(change.From, change.To) {
+			continue
+		}
+
+		hookCtx, span, start := observability.StartServiceSpan(ctx, "LifecycleHook."+h.name)
+		span.SetAttributes(
+			attribute.String("lifecycle.from", string(change.From)),
+			attribute.String("lifecycle.to", string(change.To)),
+		)
+
+		err := h.run(hookCtx, q, change)
+		observability.RecordServiceError(span, err)
+		observability.EndSpanWithLatency(span, start, 50)
+
+		if err != nil {
+			log.ErrorCtx(hookCtx, "회원 상태 변경 훅 실패", log.MapStr("hook", h.name), log.MapInt64("memberId", change.MemberID), log.MapErr("error", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// 리프레쉬 세션 / opaque access 토큰 폐기 (ACTIVE 를 벗어날 때 즉시 로그아웃)
+func RevokeSessions(ctx context.Context, q *query.Queries, change *Change) error {
+	if err := q.RevokeRefreshSessionsByMemberID(ctx, change.MemberID); err != nil {
+		return err
+	}
+	return q.RevokeAccessTokensByMemberID(ctx, change.MemberID)
+}
@@ -0,0 +1,179 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 이력 조회 최대 개수 (최신순)
+const historyLimit = 100
+
+// LifecycleService
+// - 회원 상태 변경의 단일 진입점 (허용 전이 검증 + member_status_history 기록 + 훅 실행)
+// - 훅은 라우터 구성 시점에 등록하고 이후에는 읽기만 함
+type LifecycleService struct {
+	queries *query.Queries
+	hooks   []hook
+}
+
+// 생성자
+func NewLifecycleService(queries *query.Queries) *LifecycleService {
+	return &LifecycleService{queries: queries}
+}
+
+// 상태 변경
+// - 호출자 트랜잭션(q)에서 실행 (커밋은 호출자), 회원 행을 잠근 뒤 현재 상태 기준으로 검증
+// - 같은 상태면 기록 없이 false, 변경되면 change.From 을 채우고 true
+func (s *LifecycleService) Transition(ctx context.Context, q *query.Queries, change *Change) (changed bool, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "LifecycleTransition")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	if err = validateChange(change); err != nil {
+		observability.RecordBusinessError(span, err)
+		return false, err
+	}
+
+	from, err := q.FindMemberStatusForUpdate(ctx, change.MemberID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return false, ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return false, err
+	}
+
+	if change.From != "" && change.From != from {
+		observability.RecordBusinessError(span, ErrUnexpectedStatus)
+		return false, ErrUnexpectedStatus
+	}
+	if from == change.To {
+		return false, nil
+	}
+	if !CanTransitionWith(from, change.To, change.Reason) {
+		observability.RecordBusinessError(span, ErrInvalidTransition)
+		return false, ErrInvalidTransition
+	}
+	change.From = from
+
+	// 삭제는 deleted_at 도 함께 기록
+	if change.To == model.StatusDeleted {
+		err = q.SoftDeleteMember(ctx, change.MemberID)
+	} else {
+		err = q.UpdateMemberStatus(ctx, query.UpdateMemberStatusParams{MemberID: change.MemberID, Status: change.To})
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return false, err
+	}
+
+	err = q.InsertMemberStatusHistory(ctx, query.InsertMemberStatusHistoryParams{
+		MemberID:   change.MemberID,
+		FromStatus: change.From,
+		ToStatus:   change.To,
+		Reason:     change.Reason,
+		Note:       optionalText(change.Note),
+		ActorType:  string(change.Actor.Type),
+		ActorID:    optionalText(change.Actor.ID),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return false, err
+	}
+
+	if err = s.runHooks(ctx, q, change); err != nil {
+		observability.RecordServiceError(span, err)
+		return false, err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("member.id", change.MemberID),
+		attribute.String("lifecycle.from", string(change.From)),
+		attribute.String("lifecycle.to", string(change.To)),
+		attribute.String("lifecycle.reason", change.Reason),
+		attribute.String("lifecycle.actor", string(change.Actor.Type)),
+	)
+
+	log.InfoCtx(ctx, "회원 상태 변경",
+		log.MapInt64("memberId", change.MemberID),
+		log.MapStr("from", string(change.From)),
+		log.MapStr("to", string(change.To)),
+		log.MapStr("reason", change.Reason),
+		log.MapStr("actorType", string(change.Actor.Type)),
+		log.MapStr("actorId", change.Actor.ID),
+	)
+	return true, nil
+}
+
+// 상태 변경 이력 조회 (최신순)
+func (s *LifecycleService) History(ctx context.Context, memberID int64) (resp []HistoryResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "LifecycleHistory")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	rows, err := s.queries.ListMemberStatusHistory(ctx, query.ListMemberStatusHistoryParams{
+		MemberID: memberID,
+		Limit:    historyLimit,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	resp = make([]HistoryResponse, 0, len(rows))
+	for _, row := range rows {
+		resp = append(resp, HistoryResponse{
+			ID:        row.MemberStatusHistoryID,
+			From:      row.FromStatus,
+			To:        row.ToStatus,
+			Reason:    row.Reason,
+			Note:      mapper.TextPtr(row.Note),
+			ActorType: ActorType(row.ActorType),
+			ActorID:   mapper.TextPtr(row.ActorID),
+			CreatedAt: mapper.TimeValue(row.CreatedAt),
+		})
+	}
+
+	span.SetAttributes(
+		attribute.Int64("member.id", memberID),
+		attribute.Int("lifecycle.history_count", len(resp)),
+	)
+	return resp, nil
+}
+
+// 요청 검증 (사유 코드 필수, 메모는 공백 제거 후 길이 제한)
+func validateChange(change *Change) error {
+	change.Reason = strings.TrimSpace(change.Reason)
+	change.Note = strings.TrimSpace(change.Note)
+
+	if change.Reason == "" || utf8.RuneCountInString(change.Note) > maxNoteLength {
+		return ErrInvalidReason
+	}
+	if !slices.Contains(actorTypes, change.Actor.Type) {
+		return ErrInvalidActor
+	}
+	if _, ok := transitions[change.To]; !ok && change.To != model.StatusDeleted {
+		return ErrInvalidTransition
+	}
+	return nil
+}
+
+// 빈 문자열은 NULL
+func optionalText(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: s, Valid: true}
+}
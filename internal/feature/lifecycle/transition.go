@@ -0,0 +1,87 @@
+package lifecycle
+
+import (
+	"slices"
+
+	"study/internal/shared/model"
+)
+
+// 변경 주체
+type ActorType string
+
+const (
+	ActorAdmin  ActorType = "ADMIN"  // 관리자 (ID = 관리자 회원 ID)
+	ActorMember ActorType = "MEMBER" // 회원 본인 (ID = 회원 ID)
+	ActorScim   ActorType = "SCIM"   // IdP 프로비저닝 (ID = 테넌트 ID)
+	ActorSystem ActorType = "SYSTEM" // 배치 / 내부 처리 (ID 없음)
+)
+
+var actorTypes = []ActorType{ActorAdmin, ActorMember, ActorScim, ActorSystem}
+
+// 변경 사유 코드 (상세 내용은 note)
+const (
+	ReasonAdminAction       = "ADMIN_ACTION"
+	ReasonScimProvisioning  = "SCIM_PROVISIONING"
+	ReasonScimDeprovisioned = "SCIM_DEPROVISIONED"
+	ReasonSignupApproved    = "SIGNUP_APPROVED"
+	ReasonSignupRejected    = "SIGNUP_REJECTED"
+)
+
+// 메모 최대 길이 (문자 수)
+const maxNoteLength = 500
+
+// 허용 전이 (DELETED 는 종료 상태)
+var transitions = map[model.Status][]model.Status{
+	model.StatusReady:    {model.StatusActive, model.StatusDeleted},
+	model.StatusActive:   {model.StatusDisabled, model.StatusDeleted},
+	model.StatusDisabled: {model.StatusActive, model.StatusDeleted},
+}
+
+// 사유가 고정된 전이 (승인 대기 회원은 가입 승인 / 거절로만 처리)
+// - 관리자 상태 변경, 일괄 처리, SCIM 으로 승인 절차(감사 기록, 안내 메일)를 우회하지 못하도록 제한
+var transitionReasons = map[model.Status]map[model.Status]string{
+	model.StatusReady: {
+		model.StatusActive:  ReasonSignupApproved,
+		model.StatusDeleted: ReasonSignupRejected,
+	},
+}
+
+// 상태 전이 허용 여부 (같은 상태는 전이가 아님)
+func CanTransition(from model.Status, to model.Status) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// 사유를 포함한 전이 허용 여부
+func CanTransitionWith(from model.Status, to model.Status, reason string) bool {
+	if !CanTransition(from, to) {
+		return false
+	}
+	required, ok := transitionReasons[from][to]
+	return !ok || required == reason
+}
+
+// 변경 주체
+type Actor struct {
+	Type ActorType
+	ID   string
+}
+
+// 관리자 주체
+func AdminActor(adminID string) Actor {
+	return Actor{Type: ActorAdmin, ID: adminID}
+}
+
+// SCIM 테넌트 주체
+func ScimActor(tenantID string) Actor {
+	return Actor{Type: ActorScim, ID: tenantID}
+}
+
+// 상태 변경 요청 / 훅에 전달되는 변경 내용
+type Change struct {
+	MemberID int64
+	From     model.Status // 요청 시 지정하면 현재 상태가 같을 때만 변경, 비워두면 현재 상태로 채움
+	To       model.Status
+	Reason   string
+	Note     string
+	Actor    Actor
+}
@@ -0,0 +1,130 @@
+package lifecycle
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"study/internal/query"
+	"study/internal/shared/model"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from model.Status
+		to   model.Status
+		want bool
+	}{
+		{model.StatusReady, model.StatusActive, true},
+		{model.StatusReady, model.StatusDeleted, true},
+		{model.StatusReady, model.StatusDisabled, false},
+		{model.StatusActive, model.StatusDisabled, true},
+		{model.StatusActive, model.StatusDeleted, true},
+		{model.StatusActive, model.StatusReady, false},
+		{model.StatusDisabled, model.StatusActive, true},
+		{model.StatusDisabled, model.StatusDeleted, true},
+		{model.StatusDeleted, model.StatusActive, false},
+		{model.StatusDeleted, model.StatusDisabled, false},
+		{model.StatusActive, model.StatusActive, false},
+		{model.Status("UNKNOWN"), model.StatusActive, false},
+	}
+
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCanTransitionWith(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   model.Status
+		to     model.Status
+		reason string
+		want   bool
+	}{
+		{"가입 승인", model.StatusReady, model.StatusActive, ReasonSignupApproved, true},
+		{"가입 거절", model.StatusReady, model.StatusDeleted, ReasonSignupRejected, true},
+		{"관리자 상태 변경으로 승인 우회", model.StatusReady, model.StatusActive, ReasonAdminAction, false},
+		{"SCIM 으로 승인 우회", model.StatusReady, model.StatusActive, ReasonScimProvisioning, false},
+		{"거절 사유로 활성화", model.StatusReady, model.StatusActive, ReasonSignupRejected, false},
+		{"SCIM 으로 대기 회원 삭제", model.StatusReady, model.StatusDeleted, ReasonScimDeprovisioned, false},
+		{"일반 전이는 사유 무관", model.StatusDisabled, model.StatusActive, ReasonAdminAction, true},
+		{"허용되지 않은 전이", model.StatusActive, model.StatusReady, ReasonSignupApproved, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanTransitionWith(tt.from, tt.to, tt.reason); got != tt.want {
+				t.Errorf("CanTransitionWith(%s, %s, %s) = %v, want %v", tt.from, tt.to, tt.reason, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateChange(t *testing.T) {
+	ok := &Change{MemberID: 1, To: model.StatusDisabled, Reason: " " + ReasonAdminAction + " ", Note: " 스팸 ", Actor: AdminActor("1")}
+	if err := validateChange(ok); err != nil {
+		t.Fatalf("validateChange() error = %v", err)
+	}
+	if ok.Reason != ReasonAdminAction || ok.Note != "스팸" {
+		t.Errorf("validateChange() did not trim: reason=%q note=%q", ok.Reason, ok.Note)
+	}
+
+	tests := []struct {
+		name   string
+		change Change
+		want   error
+	}{
+		{"사유 없음", Change{To: model.StatusDisabled, Actor: AdminActor("1")}, ErrInvalidReason},
+		{"메모 길이 초과", Change{To: model.StatusDisabled, Reason: ReasonAdminAction, Note: string(make([]rune, maxNoteLength+1)), Actor: AdminActor("1")}, ErrInvalidReason},
+		{"주체 없음", Change{To: model.StatusDisabled, Reason: ReasonAdminAction}, ErrInvalidActor},
+		{"알 수 없는 상태", Change{To: model.Status("LOCKED"), Reason: ReasonAdminAction, Actor: AdminActor("1")}, ErrInvalidTransition},
+		{"READY 로 되돌리기", Change{To: model.StatusReady, Reason: ReasonAdminAction, Actor: AdminActor("1")}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateChange(&tt.change); err != tt.want {
+				t.Errorf("validateChange() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHooksMatchTransition(t *testing.T) {
+	s := NewLifecycleService(nil)
+
+	var ran []string
+	record := func(name string) Hook {
+		return func(ctx context.Context, q *query.Queries, change *Change) error {
+			ran = append(ran, name)
+			return nil
+		}
+	}
+	s.OnLeave(model.StatusActive, "leave_active", record("leave_active"))
+	s.OnEnter(model.StatusActive, "enter_active", record("enter_active"))
+	s.OnEnter(model.StatusDeleted, "enter_deleted", record("enter_deleted"))
+
+	tests := []struct {
+		from model.Status
+		to   model.Status
+		want []string
+	}{
+		{model.StatusActive, model.StatusDisabled, []string{"leave_active"}},
+		{model.StatusActive, model.StatusDeleted, []string{"leave_active", "enter_deleted"}},
+		{model.StatusReady, model.StatusActive, []string{"enter_active"}},
+		{model.StatusDisabled, model.StatusDeleted, []string{"enter_deleted"}},
+	}
+
+	for _, tt := range tests {
+		ran = nil
+		if err := s.runHooks(context.Background(), nil, &Change{From: tt.from, To: tt.to}); err != nil {
+			t.Fatalf("runHooks() error = %v", err)
+		}
+		if !slices.Equal(ran, tt.want) {
+			t.Errorf("%s -> %s ran %v, want %v", tt.from, tt.to, ran, tt.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package member
+
+import (
+	"encoding/json"
+	"time"
+
+	"study/internal/shared/model"
+	"study/internal/shared/profileimage"
+)
+
+// 부분 수정 필드 (없음 / null / 값 구분)
+// - Set=false : 요청에 필드 없음 (변경하지 않음)
+// - Set=true, Null=true : null (값 삭제)
+type Optional[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &o.Value)
+}
+
+// 내 프로필 수정 요청 DTO (전달한 필드만 변경, 프로필 이미지는 업로드 API 사용)
+type UpdateProfileRequest struct {
+	Name    Optional[string] `json:"name"`
+	Tel     Optional[string] `json:"tel"`
+	Address Optional[string] `json:"address"`
+}
+
+// 변경할 필드가 없는 요청
+func (r *UpdateProfileRequest) Empty() bool {
+	return !r.Name.Set && !r.Tel.Set && !r.Address.Set
+}
+
+// 내 프로필 응답 DTO
+type ProfileResponse struct {
+	ID            int64                  `json:"id"`
+	Email         string                 `json:"email"`
+	Name          string                 `json:"name"`
+	Tel           *string                `json:"tel"`
+	TelVerifiedAt *time.Time             `json:"telVerifiedAt"`
+	Address       *string                `json:"address"`
+	ProfileImage  *profileimage.Response `json:"profileImage"`
+	Roles         []model.Role           `json:"roles"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	UpdatedAt     *time.Time             `json:"updatedAt"`
+}
+
+// 전화번호 인증번호 발송 요청 DTO
+type TelVerificationRequest struct {
+	Tel string `json:"tel"`
+}
+
+// 전화번호 인증번호 발송 응답 DTO
+type TelVerificationResponse struct {
+	Tel            string    `json:"tel"` // E.164 로 정규화된 번호
+	ExpiresAt      time.Time `json:"expiresAt"`
+	ResendAfterSec int       `json:"resendAfterSec"`
+}
+
+// 전화번호 인증번호 확인 요청 DTO
+type ConfirmTelVerificationRequest struct {
+	Code string `json:"code"`
+}
+
+// 회원 검색 결과 DTO (멘션용, 활성 회원의 공개 정보만)
+type MemberSearchResponse struct {
+	ID            int64   `json:"id"`
+	Name          string  `json:"name"`
+	NameHighlight string  `json:"nameHighlight"` // 검색어 일치 부분 <em> 강조 (HTML 이스케이프)
+	Rank          float64 `json:"rank"`
+}
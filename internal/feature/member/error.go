@@ -0,0 +1,54 @@
+package member
+
+import "errors"
+
+// 서비스 에러
+var (
+	// 회원 없음 (탈퇴 / 비활성 포함)
+	ErrMemberNotFound = errors.New("MEMBER_NOT_FOUND")
+
+	// 이름 형식 오류 (빈 값, null, 길이 초과)
+	ErrInvalidName = errors.New("INVALID_NAME")
+
+	// 전화번호 형식 오류
+	ErrInvalidTel = errors.New("INVALID_TEL")
+
+	// SMS 를 받을 수 없는 번호 (국내 유선 번호 등)
+	ErrNotMobileTel = errors.New("NOT_MOBILE_TEL")
+
+	// 이미 인증된 번호
+	ErrTelAlreadyVerified = errors.New("TEL_ALREADY_VERIFIED")
+
+	// 재발송 대기 시간 이내
+	ErrVerificationCooldown = errors.New("VERIFICATION_COOLDOWN")
+
+	// 24시간 발송 횟수 초과
+	ErrVerificationLimitExceeded = errors.New("VERIFICATION_LIMIT_EXCEEDED")
+
+	// 유효한 인증 요청 없음 (만료 / 미요청)
+	ErrVerificationNotFound = errors.New("VERIFICATION_NOT_FOUND")
+
+	// 인증번호 불일치
+	ErrInvalidVerificationCode = errors.New("INVALID_VERIFICATION_CODE")
+
+	// 확인 시도 횟수 초과 (재발송 필요)
+	ErrVerificationAttemptsExceeded = errors.New("VERIFICATION_ATTEMPTS_EXCEEDED")
+
+	// 인증번호 발송 실패
+	ErrSMSSendFailed = errors.New("SMS_SEND_FAILED")
+
+	// 주소 형식 오류
+	ErrInvalidAddress = errors.New("INVALID_ADDRESS")
+
+	// 프로필 이미지 용량 초과
+	ErrImageTooLarge = errors.New("IMAGE_TOO_LARGE")
+
+	// 허용하지 않는 이미지 형식 (jpeg, png, webp 만 허용)
+	ErrUnsupportedImage = errors.New("UNSUPPORTED_IMAGE")
+
+	// 손상되었거나 크기 제한을 벗어난 이미지
+	ErrInvalidImage = errors.New("INVALID_IMAGE")
+
+	// 프로필 이미지 없음
+	ErrProfileImageNotFound = errors.New("PROFILE_IMAGE_NOT_FOUND")
+)
@@ -0,0 +1,224 @@
+package member
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+
+	"study/internal/shared/profileimage"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// 프로필 이미지 제한
+// - 디코딩 중 메모리는 픽셀 수 × 약 8바이트 (원본 + RGBA 변환본), 16M 픽셀이면 약 128MB
+const (
+	maxImageSide   = 8000       // 가로 / 세로 최대 픽셀
+	maxImagePixels = 16_000_000 // 전체 픽셀 수 (압축 폭탄 방지)
+	minImageSide   = 32
+	imageQuality   = 85
+
+	maxImageDecodes = 2 // 동시 디코딩 수 (인스턴스 전체)
+)
+
+// 동시 디코딩 제한 (업로드가 몰려도 디코딩 메모리는 maxImageDecodes 배까지)
+var imageDecodeSlots = make(chan struct{}, maxImageDecodes)
+
+// 디코딩 슬롯 대기 (요청이 취소되면 ctx 에러)
+func acquireImageDecode(ctx context.Context) (release func(), err error) {
+	select {
+	case imageDecodeSlots <- struct{}{}:
+		return func() { <-imageDecodeSlots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// 업로드 허용 형식 (본문 스니핑 기준, 클라이언트 Content-Type 은 신뢰하지 않음)
+var allowedImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// 썸네일 결과 (JPEG)
+type thumbnail struct {
+	Size profileimage.Size
+	Data []byte
+}
+
+// 업로드 이미지 → 표준 썸네일
+// - 형식 스니핑, 디코딩 전 크기 확인
+// - EXIF 방향 적용 후 중앙 정사각형 자르기 + 리사이즈
+// - JPEG 재인코딩 (EXIF 등 메타데이터 제거, 투명 배경은 흰색)
+func makeThumbnails(data []byte) ([]thumbnail, error) {
+	contentType := http.DetectContentType(data)
+	if !allowedImageTypes[contentType] {
+		return nil, ErrUnsupportedImage
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if cfg.Width < minImageSide || cfg.Height < minImageSide ||
+		cfg.Width > maxImageSide || cfg.Height > maxImageSide ||
+		cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrInvalidImage
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	img := flatten(src)
+	if contentType == "image/jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+	square := centerSquare(img)
+
+	thumbnails := make([]thumbnail, 0, len(profileimage.Sizes))
+	for _, size := range profileimage.Sizes {
+		// 원본보다 크게 늘리지 않음
+		side := min(size.Side, square.Dx())
+		dst := image.NewRGBA(image.Rect(0, 0, side, side))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, square, draw.Src, nil)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: imageQuality}); err != nil {
+			return nil, err
+		}
+		thumbnails = append(thumbnails, thumbnail{Size: size, Data: buf.Bytes()})
+	}
+
+	return thumbnails, nil
+}
+
+// 흰 배경 위에 합성 (좌표 0,0 기준 RGBA)
+func flatten(src image.Image) *image.RGBA {
+	b := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Over)
+	return dst
+}
+
+// 가운데 정사각형 영역
+func centerSquare(img image.Image) image.Rectangle {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	return image.Rect(x, y, x+side, y+side)
+}
+
+// EXIF 방향(1~8) 적용
+// - 2: 좌우 반전, 3: 180도, 4: 상하 반전, 5: 전치, 6: 시계 90도, 7: 역전치, 8: 반시계 90도
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			dst.SetRGBA(x, y, src.RGBAAt(sx, sy))
+		}
+	}
+
+	return dst
+}
+
+// JPEG APP1(Exif) 의 IFD0 Orientation(0x0112) 읽기, 없으면 1
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// SOS 이후는 이미지 데이터
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+
+	return 1
+}
+
+// TIFF 헤더 + IFD0 에서 Orientation 찾기
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,186 @@
+package member
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// 왼쪽 절반 빨강, 오른쪽 절반 파랑
+func halfImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// SOI 뒤에 Orientation 만 있는 Exif APP1 삽입 (big endian TIFF)
+func withExifOrientation(jpg []byte, orientation byte) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01,
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, orientation, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	length := len(payload) + 2
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, 0xFF, 0xE1, byte(length>>8), byte(length))
+	out = append(out, payload...)
+	return append(out, jpg[2:]...)
+}
+
+func TestJpegOrientation(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, halfImage(40, 20), nil); err != nil {
+		t.Fatalf("인코딩 실패: %v", err)
+	}
+
+	if got := jpegOrientation(buf.Bytes()); got != 1 {
+		t.Errorf("EXIF 없으면 1: %d", got)
+	}
+	if got := jpegOrientation(withExifOrientation(buf.Bytes(), 6)); got != 6 {
+		t.Errorf("Orientation 파싱 실패: %d", got)
+	}
+}
+
+func TestOrient(t *testing.T) {
+	src := halfImage(40, 20)
+
+	// 시계 방향 90도: 왼쪽(빨강)이 위로
+	rotated := orient(src, 6)
+	if b := rotated.Bounds(); b.Dx() != 20 || b.Dy() != 40 {
+		t.Fatalf("회전 크기 오류: %v", b)
+	}
+	if c := rotated.RGBAAt(10, 5); c.R != 255 {
+		t.Errorf("위쪽은 빨강이어야 함: %+v", c)
+	}
+	if c := rotated.RGBAAt(10, 35); c.B != 255 {
+		t.Errorf("아래쪽은 파랑이어야 함: %+v", c)
+	}
+
+	// 반시계 방향 90도: 왼쪽(빨강)이 아래로
+	if c := orient(src, 8).RGBAAt(10, 35); c.R != 255 {
+		t.Errorf("반시계 회전 시 아래쪽은 빨강이어야 함: %+v", c)
+	}
+}
+
+func TestMakeThumbnails(t *testing.T) {
+	// 투명 PNG (원본보다 큰 썸네일은 만들지 않음)
+	transparent := image.NewNRGBA(image.Rect(0, 0, 600, 400))
+	var pngBuf bytes.Buffer
+	if err := png.Encode(&pngBuf, transparent); err != nil {
+		t.Fatalf("인코딩 실패: %v", err)
+	}
+
+	thumbnails, err := makeThumbnails(pngBuf.Bytes())
+	if err != nil {
+		t.Fatalf("썸네일 생성 실패: %v", err)
+	}
+
+	want := map[string]int{"small": 64, "medium": 256, "large": 400}
+	for _, th := range thumbnails {
+		img, format, err := image.Decode(bytes.NewReader(th.Data))
+		if err != nil || format != "jpeg" {
+			t.Fatalf("%s: JPEG 가 아님: %v %s", th.Size.Name, err, format)
+		}
+		if b := img.Bounds(); b.Dx() != want[th.Size.Name] || b.Dy() != want[th.Size.Name] {
+			t.Errorf("%s: 크기 오류 %v", th.Size.Name, b)
+		}
+		// 투명 배경은 흰색
+		if r, g, b, _ := img.At(0, 0).RGBA(); r>>8 < 250 || g>>8 < 250 || b>>8 < 250 {
+			t.Errorf("%s: 배경이 흰색이 아님", th.Size.Name)
+		}
+	}
+
+	// EXIF 는 재인코딩으로 제거
+	var jpgBuf bytes.Buffer
+	if err := jpeg.Encode(&jpgBuf, halfImage(100, 100), nil); err != nil {
+		t.Fatalf("인코딩 실패: %v", err)
+	}
+	thumbnails, err = makeThumbnails(withExifOrientation(jpgBuf.Bytes(), 6))
+	if err != nil {
+		t.Fatalf("썸네일 생성 실패: %v", err)
+	}
+	for _, th := range thumbnails {
+		if bytes.Contains(th.Data, []byte("Exif")) {
+			t.Errorf("%s: EXIF 가 남아있음", th.Size.Name)
+		}
+	}
+}
+
+func TestMakeThumbnailsRejects(t *testing.T) {
+	var gifBuf bytes.Buffer
+	if err := gif.Encode(&gifBuf, halfImage(64, 64), nil); err != nil {
+		t.Fatalf("인코딩 실패: %v", err)
+	}
+	var tiny bytes.Buffer
+	if err := png.Encode(&tiny, halfImage(16, 16)); err != nil {
+		t.Fatalf("인코딩 실패: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"GIF", gifBuf.Bytes(), ErrUnsupportedImage},
+		{"텍스트", []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), ErrUnsupportedImage},
+		{"손상된 PNG", pngHeaderOnly(), ErrInvalidImage},
+		{"너무 작음", tiny.Bytes(), ErrInvalidImage},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := makeThumbnails(tc.data); err != tc.err {
+				t.Errorf("결과 불일치: %v, 기대: %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestAcquireImageDecode(t *testing.T) {
+	releases := make([]func(), 0, maxImageDecodes)
+	for i := 0; i < maxImageDecodes; i++ {
+		release, err := acquireImageDecode(context.Background())
+		if err != nil {
+			t.Fatalf("슬롯 획득 실패: %v", err)
+		}
+		releases = append(releases, release)
+	}
+
+	// 슬롯이 모두 사용 중이면 ctx 종료까지 대기
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := acquireImageDecode(ctx); err != context.Canceled {
+		t.Errorf("결과 불일치: %v, 기대: %v", err, context.Canceled)
+	}
+
+	releases[0]()
+	release, err := acquireImageDecode(context.Background())
+	if err != nil {
+		t.Fatalf("반환 후 획득 실패: %v", err)
+	}
+	release()
+	for _, release := range releases[1:] {
+		release()
+	}
+}
+
+// PNG 시그니처만 있는 손상 파일
+func pngHeaderOnly() []byte {
+	return []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x00")
+}
@@ -0,0 +1,198 @@
+package member
+
+import (
+	"io"
+
+	"study/internal/feature/auth"
+	"study/internal/shared/errorx"
+	"study/internal/shared/pagination"
+	"study/internal/shared/search"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Handler
+type MemberHandler struct {
+	service *MemberService
+}
+
+func NewMemberHandler(service *MemberService) *MemberHandler {
+	return &MemberHandler{service: service}
+}
+
+// 내 프로필 조회
+func (h *MemberHandler) GetMe(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	profile, err := h.service.GetProfile(ctx, claims.MemberID)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "프로필 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("프로필 조회 성공", profile))
+}
+
+// 내 프로필 수정 (부분 수정)
+func (h *MemberHandler) UpdateMe(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	var req UpdateProfileRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	profile, err := h.service.UpdateProfile(ctx, claims.MemberID, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "프로필 수정 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("프로필 수정 성공", profile))
+}
+
+// 회원 검색 (멘션용, q + cursor, limit, sort)
+func (h *MemberHandler) Search(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	page, err := h.service.SearchMembers(ctx, c.Query("q"), c.Queries())
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "회원 검색 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("회원 검색 성공", page))
+}
+
+// 프로필 이미지 업로드 (multipart, image 필드)
+func (h *MemberHandler) UploadProfileImage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "image 파일 필요", nil))
+	}
+
+	// 선언 크기 먼저 확인 후 제한 크기 + 1 까지만 읽음 (서비스에서 초과 판정)
+	maxBytes := h.service.MaxUploadBytes()
+	if file.Size > maxBytes {
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(response.Error(ErrImageTooLarge.Error(), "프로필 이미지 업로드 실패", nil))
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "파일 읽기 실패", nil))
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxBytes+1))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "파일 읽기 실패", nil))
+	}
+
+	profile, err := h.service.UploadProfileImage(ctx, claims.MemberID, data)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "프로필 이미지 업로드 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("프로필 이미지 업로드 성공", profile))
+}
+
+// 프로필 이미지 삭제
+func (h *MemberHandler) DeleteProfileImage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	if err := h.service.DeleteProfileImage(ctx, claims.MemberID); err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "프로필 이미지 삭제 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("프로필 이미지 삭제 성공", nil))
+}
+
+// 전화번호 인증번호 발송
+func (h *MemberHandler) RequestTelVerification(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	var req TelVerificationRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	verification, err := h.service.RequestTelVerification(ctx, claims.MemberID, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "인증번호 발송 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("인증번호 발송 성공", verification))
+}
+
+// 전화번호 인증번호 확인
+func (h *MemberHandler) ConfirmTelVerification(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	var req ConfirmTelVerificationRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	profile, err := h.service.ConfirmTelVerification(ctx, claims.MemberID, &req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "전화번호 인증 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("전화번호 인증 성공", profile))
+}
+
+// 서비스 에러 → HTTP 상태
+func errorStatus(err error) int {
+	switch err {
+	case ErrMemberNotFound, ErrProfileImageNotFound, ErrVerificationNotFound:
+		return fiber.StatusNotFound
+	case ErrInvalidName, ErrInvalidTel, ErrInvalidAddress, ErrInvalidImage, ErrNotMobileTel, ErrInvalidVerificationCode,
+		search.ErrInvalidQuery, pagination.ErrInvalidLimit, pagination.ErrInvalidSort, pagination.ErrInvalidFilter, pagination.ErrInvalidCursor:
+		return fiber.StatusBadRequest
+	case ErrImageTooLarge:
+		return fiber.StatusRequestEntityTooLarge
+	case ErrUnsupportedImage:
+		return fiber.StatusUnsupportedMediaType
+	case ErrTelAlreadyVerified:
+		return fiber.StatusConflict
+	case ErrVerificationCooldown, ErrVerificationLimitExceeded, ErrVerificationAttemptsExceeded:
+		return fiber.StatusTooManyRequests
+	case ErrSMSSendFailed:
+		return fiber.StatusBadGateway
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
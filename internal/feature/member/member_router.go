@@ -0,0 +1,27 @@
+package member
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type MemberRouter struct {
+	handler *MemberHandler
+}
+
+func NewMemberRouter(handler *MemberHandler) *MemberRouter {
+	return &MemberRouter{handler: handler}
+}
+
+func (r *MemberRouter) RegisterAuthRoutes(
+	auth fiber.Router,
+) {
+	members := auth.Group("/members")
+
+	members.Get("/search", r.handler.Search)
+	members.Get("/me", r.handler.GetMe)
+	members.Patch("/me", r.handler.UpdateMe)
+	members.Post("/me/profile-image", r.handler.UploadProfileImage)
+	members.Delete("/me/profile-image", r.handler.DeleteProfileImage)
+	members.Post("/me/tel/verification", r.handler.RequestTelVerification)
+	members.Post("/me/tel/verification/confirm", r.handler.ConfirmTelVerification)
+}
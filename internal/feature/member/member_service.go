@@ -0,0 +1,327 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"study/internal/config"
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/internal/shared/pagination"
+	"study/internal/shared/profileimage"
+	"study/internal/shared/search"
+	"study/internal/sms"
+	"study/internal/storage"
+	"study/pkg/log"
+	"study/pkg/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MemberService
+// - 로그인한 회원 본인의 프로필 조회/수정 전용
+type MemberService struct {
+	pool         *pgxpool.Pool
+	queries      *query.Queries
+	storage      storage.Storage
+	sms          sms.Sender
+	profiles     *profileimage.Resolver
+	cfg          *config.Storage
+	telVerifyCfg *config.PhoneVerification
+}
+
+// 생성자
+func NewMemberService(pool *pgxpool.Pool, queries *query.Queries, storage storage.Storage, sms sms.Sender, cfg *config.Storage, telVerifyCfg *config.PhoneVerification) *MemberService {
+	return &MemberService{
+		pool:         pool,
+		queries:      queries,
+		storage:      storage,
+		sms:          sms,
+		profiles:     profileimage.NewResolver(storage, cfg),
+		cfg:          cfg,
+		telVerifyCfg: telVerifyCfg,
+	}
+}
+
+// 내 프로필 조회
+func (s *MemberService) GetProfile(ctx context.Context, memberID int64) (resp *ProfileResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "GetProfile")
+	defer observability.EndSpanWithLatency(span, start, 30)
+
+	// 회원 찾기 (활성 회원만)
+	m, err := s.queries.FindMemberByID(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && m.Status != model.StatusActive) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 권한 조회
+	roles, err := s.queries.GetRolesByMemberID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int64("member.id", memberID))
+
+	return s.profileResponse(ctx, m, roles)
+}
+
+// 내 프로필 수정 (전달한 필드만 변경, null 은 값 삭제)
+func (s *MemberService) UpdateProfile(ctx context.Context, memberID int64, req *UpdateProfileRequest) (resp *ProfileResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "UpdateProfile")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	if err = validateProfile(req); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// 변경할 필드가 없으면 현재 프로필 반환 (updated_at 유지)
+	if req.Empty() {
+		return s.GetProfile(ctx, memberID)
+	}
+
+	// 프로필 수정 (updated_at 갱신)
+	m, err := s.queries.UpdateMemberProfile(ctx, query.UpdateMemberProfileParams{
+		SetName:    req.Name.Set,
+		Name:       req.Name.Value,
+		SetTel:     req.Tel.Set,
+		Tel:        optionalText(req.Tel),
+		SetAddress: req.Address.Set,
+		Address:    optionalText(req.Address),
+		MemberID:   memberID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 권한 조회
+	roles, err := s.queries.GetRolesByMemberID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("member.action", "update_profile"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "프로필 수정 성공", log.MapInt64("memberId", memberID))
+	return s.profileResponse(ctx, m, roles)
+}
+
+// 회원 검색 (멘션용, 활성 회원 이름)
+// - 이메일은 공개 정보가 아니므로 검색 대상에서도 제외 (이메일 검색은 관리자 검색만)
+func (s *MemberService) SearchMembers(ctx context.Context, q string, params map[string]string) (resp *pagination.Page[MemberSearchResponse], err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "SearchMembers")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	sq, err := search.Parse(q)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	req, err := pagination.Parse(searchSpec, params)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	where, args := req.Where(query.SearchMembersArgStart)
+	rows, err := s.queries.SearchMembers(ctx, query.SearchMembersParams{
+		Query:      sq.Text,
+		Pattern:    sq.Pattern,
+		Ngrams:     sq.Ngrams,
+		TsQuery:    sq.TsQuery,
+		ActiveOnly: true,
+		Where:      where,
+		Args:       args,
+		OrderBy:    req.OrderBy(),
+		Limit:      req.FetchLimit(),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	items := make([]MemberSearchResponse, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, MemberSearchResponse{
+			ID:            row.MemberID,
+			Name:          row.Name,
+			NameHighlight: search.Highlight(row.Name, sq),
+			Rank:          row.Rank,
+		})
+	}
+
+	span.SetAttributes(attribute.Int("member.search_count", len(items)))
+
+	return pagination.NewPage(req, items, searchSortKey)
+}
+
+// 프로필 이미지 업로드 (기존 이미지 교체)
+// - 표준 썸네일 생성 → 저장소 업로드 → DB 교체 → 이전 파일 정리
+// - DB 교체 실패 시 새로 올린 파일 삭제
+func (s *MemberService) UploadProfileImage(ctx context.Context, memberID int64, data []byte) (resp *ProfileResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "UploadProfileImage")
+	defer observability.EndSpanWithLatency(span, start, 500)
+
+	if int64(len(data)) > s.MaxUploadBytes() {
+		observability.RecordBusinessError(span, ErrImageTooLarge)
+		return nil, ErrImageTooLarge
+	}
+
+	release, err := acquireImageDecode(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	thumbnails, err := makeThumbnails(data)
+	release()
+	if errors.Is(err, ErrUnsupportedImage) || errors.Is(err, ErrInvalidImage) {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 교체 시마다 새 경로 (CDN / 브라우저 캐시 무효화)
+	random, err := util.RandomString(12)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	prefix := fmt.Sprintf("%s%d/%s", profileimage.KeyPrefix, memberID, random)
+
+	uploaded := make([]string, 0, len(thumbnails))
+	for _, t := range thumbnails {
+		key := profileimage.Key(prefix, t.Size)
+		if err = s.storage.Put(ctx, key, t.Data, "image/jpeg"); err != nil {
+			s.deleteFiles(ctx, uploaded)
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		uploaded = append(uploaded, key)
+	}
+
+	old, err := s.queries.ReplaceMemberProfileImage(ctx, query.ReplaceMemberProfileImageParams{
+		Profile:  pgtype.Text{String: prefix, Valid: true},
+		MemberID: memberID,
+	})
+	if err != nil {
+		s.deleteFiles(ctx, uploaded)
+		if errors.Is(err, pgx.ErrNoRows) {
+			observability.RecordBusinessError(span, ErrMemberNotFound)
+			return nil, ErrMemberNotFound
+		}
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 이전 이미지 정리 (실패해도 업로드는 성공)
+	s.deleteFiles(ctx, profileimage.Keys(memberID, old))
+
+	span.SetAttributes(
+		attribute.String("member.action", "upload_profile_image"),
+		attribute.Int64("member.id", memberID),
+		attribute.Int("member.image_bytes", len(data)),
+	)
+
+	log.InfoCtx(ctx, "프로필 이미지 업로드 성공", log.MapInt64("memberId", memberID), log.MapStr("prefix", prefix))
+	return s.GetProfile(ctx, memberID)
+}
+
+// 프로필 이미지 삭제
+func (s *MemberService) DeleteProfileImage(ctx context.Context, memberID int64) (err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "DeleteProfileImage")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	old, err := s.queries.ReplaceMemberProfileImage(ctx, query.ReplaceMemberProfileImageParams{
+		MemberID: memberID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+	if !old.Valid {
+		observability.RecordBusinessError(span, ErrProfileImageNotFound)
+		return ErrProfileImageNotFound
+	}
+
+	s.deleteFiles(ctx, profileimage.Keys(memberID, old))
+
+	span.SetAttributes(
+		attribute.String("member.action", "delete_profile_image"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "프로필 이미지 삭제 성공", log.MapInt64("memberId", memberID))
+	return nil
+}
+
+// 프로필 이미지 업로드 최대 크기 (바이트)
+func (s *MemberService) MaxUploadBytes() int64 {
+	return int64(s.cfg.MaxUploadMB) * 1024 * 1024
+}
+
+// 저장소 파일 삭제 (정리 작업이라 실패는 로그만)
+func (s *MemberService) deleteFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			log.WarnCtx(ctx, "프로필 이미지 파일 삭제 실패", log.MapStr("key", key), log.MapErr("error", err))
+		}
+	}
+}
+
+// 부분 수정 필드 → nullable 컬럼 값 (null 이면 Valid=false)
+func optionalText(o Optional[string]) pgtype.Text {
+	if !o.Set || o.Null {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: o.Value, Valid: true}
+}
+
+// 회원 → 프로필 응답 (프로필 이미지는 기간 제한 URL)
+func (s *MemberService) profileResponse(ctx context.Context, m query.Member, roles []model.Role) (*ProfileResponse, error) {
+	image, err := s.profiles.Resolve(ctx, m.MemberID, m.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfileResponse{
+		ID:            m.MemberID,
+		Email:         m.Email,
+		Name:          m.Name,
+		Tel:           mapper.TextPtr(m.Tel),
+		TelVerifiedAt: mapper.TimePtr(m.TelVerifiedAt),
+		Address:       mapper.TextPtr(m.Address),
+		ProfileImage:  image,
+		Roles:         roles,
+		CreatedAt:     mapper.TimeValue(m.CreatedAt),
+		UpdatedAt:     mapper.TimePtr(m.UpdatedAt),
+	}, nil
+}
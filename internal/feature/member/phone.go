@@ -0,0 +1,60 @@
+package member
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 입력 형식: 선택적 국가번호(+), 숫자 그룹은 하이픈 / 공백으로 구분 (예: 010-1234-5678, +82 10 1234 5678)
+var telPattern = regexp.MustCompile(`^\+?[0-9]+([- ][0-9]+)*$`)
+
+// E.164: + 국가번호(1~9 시작) 포함 최대 15자리
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// 국내 휴대전화 (국가번호 뒤, 앞자리 0 제외)
+// - 010: 뒤 8자리, 011 / 016 / 017 / 018 / 019: 뒤 7~8자리
+var krMobilePattern = regexp.MustCompile(`^\+82(10[0-9]{8}|1[16789][0-9]{7,8})$`)
+
+const krCountryCode = "+82"
+
+// 전화번호 → E.164
+// - 0 으로 시작하면 국내 번호 (+82, 앞자리 0 제거)
+// - +82 뒤의 0 은 국내 표기 습관이므로 제거 (+82 010-... → +8210...)
+// - 국내 번호는 앞자리 0 제외 8~10자리
+func NormalizeTel(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if !telPattern.MatchString(raw) {
+		return "", ErrInvalidTel
+	}
+
+	digits := strings.NewReplacer("+", "", "-", "", " ", "").Replace(raw)
+
+	var tel string
+	switch {
+	case strings.HasPrefix(raw, krCountryCode):
+		tel = krCountryCode + strings.TrimPrefix(digits[2:], "0")
+	case strings.HasPrefix(raw, "+"):
+		tel = "+" + digits
+	case strings.HasPrefix(digits, "0"):
+		tel = krCountryCode + digits[1:]
+	default:
+		return "", ErrInvalidTel
+	}
+
+	if !e164Pattern.MatchString(tel) {
+		return "", ErrInvalidTel
+	}
+	if national := strings.TrimPrefix(tel, krCountryCode); national != tel && (len(national) < 8 || len(national) > 10) {
+		return "", ErrInvalidTel
+	}
+
+	return tel, nil
+}
+
+// SMS 수신 가능 번호 (국내는 휴대전화만, 해외는 번호 체계를 알 수 없으므로 허용)
+func isMobileTel(tel string) bool {
+	if strings.HasPrefix(tel, krCountryCode) {
+		return krMobilePattern.MatchString(tel)
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package member
+
+import (
+	"study/internal/query"
+	"study/internal/shared/pagination"
+)
+
+// 멘션 검색 (q 외 cursor, limit, sort)
+// - sort: rank(기본, 내림차순) | id | name
+var searchSpec = &pagination.Spec{
+	DefaultLimit: 10,
+	MaxLimit:     50,
+	Sorts: map[string]pagination.Field{
+		"rank": {Column: query.SearchColumnRank, Type: pagination.TypeFloat},
+		"id":   {Column: query.SearchColumnMemberID, Type: pagination.TypeInt},
+		"name": {Column: query.SearchColumnName, Type: pagination.TypeString},
+	},
+	DefaultSort: "-rank",
+	Key:         "id",
+}
+
+// 검색 결과 → 정렬 키 값 (커서)
+func searchSortKey(m MemberSearchResponse, name string) any {
+	switch name {
+	case "rank":
+		return m.Rank
+	case "name":
+		return m.Name
+	default:
+		return m.ID
+	}
+}
@@ -0,0 +1,269 @@
+package member
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/pkg/log"
+	"study/pkg/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 24시간 발송 횟수 기준
+const telVerifyDailyWindowSec = 24 * 60 * 60
+
+// 전화번호 인증번호 발송
+// - 번호는 E.164 로 정규화, 국내 번호는 휴대전화만 허용
+// - 재발송 대기 시간 / 24시간 발송 횟수 제한, 새 코드 발급 시 이전 코드 만료
+func (s *MemberService) RequestTelVerification(ctx context.Context, memberID int64, req *TelVerificationRequest) (resp *TelVerificationResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "RequestTelVerification")
+	defer observability.EndSpanWithLatency(span, start, 300)
+
+	tel, err := NormalizeTel(req.Tel)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+	if !isMobileTel(tel) {
+		observability.RecordBusinessError(span, ErrNotMobileTel)
+		return nil, ErrNotMobileTel
+	}
+
+	// 발송 제한 확인 ~ 새 코드 저장을 회원 행 잠금 안에서 처리 (동시 요청으로 제한 우회 방지)
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	status, err := transaction.FindMemberStatusForUpdate(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && status != model.StatusActive) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 이미 같은 번호로 인증된 회원
+	m, err := transaction.FindMemberByID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	if m.TelVerifiedAt.Valid && mapper.TextValue(m.Tel) == tel {
+		observability.RecordBusinessError(span, ErrTelAlreadyVerified)
+		return nil, ErrTelAlreadyVerified
+	}
+
+	// 발송 제한
+	if err = s.checkTelVerifyLimit(ctx, transaction, memberID); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	code, err := verificationCode(s.telVerifyCfg.CodeLength)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	codeHash, err := util.HashString(code)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 이전 코드 만료 + 새 코드 저장
+	if err = transaction.ExpirePhoneVerifications(ctx, memberID); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	verification, err := transaction.CreatePhoneVerification(ctx, query.CreatePhoneVerificationParams{
+		MemberID:  memberID,
+		Tel:       tel,
+		CodeHash:  codeHash,
+		ExpireMin: int32(s.telVerifyCfg.ExpireMin),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 발송 실패 시 코드 만료 (발송 횟수에는 포함)
+	text := fmt.Sprintf("[study] 인증번호 [%s] 를 %d분 안에 입력해주세요.", code, s.telVerifyCfg.ExpireMin)
+	if err = s.sms.Send(ctx, tel, text); err != nil {
+		if expireErr := s.queries.ExpirePhoneVerifications(ctx, memberID); expireErr != nil {
+			log.WarnCtx(ctx, "발송 실패 인증번호 만료 처리 실패", log.MapInt64("memberId", memberID), log.MapErr("error", expireErr))
+		}
+		log.ErrorCtx(ctx, "인증번호 SMS 발송 실패", log.MapInt64("memberId", memberID), log.MapErr("error", err))
+		observability.RecordServiceError(span, err)
+		return nil, ErrSMSSendFailed
+	}
+
+	span.SetAttributes(
+		attribute.String("member.action", "request_tel_verification"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "전화번호 인증번호 발송", log.MapInt64("memberId", memberID), log.MapInt64("verificationId", verification.VerificationID))
+	return &TelVerificationResponse{
+		Tel:            tel,
+		ExpiresAt:      mapper.TimeValue(verification.ExpiresAt),
+		ResendAfterSec: s.telVerifyCfg.ResendCooldownSec,
+	}, nil
+}
+
+// 전화번호 인증번호 확인
+// - 최신 유효 코드만 확인, 틀리면 시도 횟수 증가 (초과 시 재발송 필요)
+// - 성공 시 회원 전화번호를 인증된 번호로 변경
+func (s *MemberService) ConfirmTelVerification(ctx context.Context, memberID int64, req *ConfirmTelVerificationRequest) (resp *ProfileResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ConfirmTelVerification")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		observability.RecordBusinessError(span, ErrInvalidVerificationCode)
+		return nil, ErrInvalidVerificationCode
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	// 동시 확인 요청 방지 (FOR UPDATE)
+	verification, err := transaction.FindActivePhoneVerification(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordBusinessError(span, ErrVerificationNotFound)
+		return nil, ErrVerificationNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	if int(verification.Attempts) >= s.telVerifyCfg.MaxAttempts {
+		observability.RecordBusinessError(span, ErrVerificationAttemptsExceeded)
+		return nil, ErrVerificationAttemptsExceeded
+	}
+
+	if util.VerifyHashString(code, verification.CodeHash) != nil {
+		attempts, err := transaction.IncrementPhoneVerificationAttempts(ctx, verification.VerificationID)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		if err = tx.Commit(ctx); err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+
+		err = ErrInvalidVerificationCode
+		if int(attempts) >= s.telVerifyCfg.MaxAttempts {
+			err = ErrVerificationAttemptsExceeded
+		}
+		log.WarnCtx(ctx, "전화번호 인증번호 불일치", log.MapInt64("memberId", memberID), log.MapInt("attempts", int(attempts)))
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	if err = transaction.MarkPhoneVerificationVerified(ctx, verification.VerificationID); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	m, err := transaction.VerifyMemberTel(ctx, query.VerifyMemberTelParams{
+		Tel:      pgtype.Text{String: verification.Tel, Valid: true},
+		MemberID: memberID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	roles, err := s.queries.GetRolesByMemberID(ctx, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("member.action", "confirm_tel_verification"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "전화번호 인증 성공", log.MapInt64("memberId", memberID))
+	return s.profileResponse(ctx, m, roles)
+}
+
+// 재발송 대기 시간 / 24시간 발송 횟수 확인 (회원 행 잠금 트랜잭션 안에서 호출)
+func (s *MemberService) checkTelVerifyLimit(ctx context.Context, q *query.Queries, memberID int64) error {
+	if s.telVerifyCfg.ResendCooldownSec > 0 {
+		recent, err := q.CountPhoneVerificationsSince(ctx, query.CountPhoneVerificationsSinceParams{
+			MemberID:  memberID,
+			WindowSec: int32(s.telVerifyCfg.ResendCooldownSec),
+		})
+		if err != nil {
+			return err
+		}
+		if recent > 0 {
+			return ErrVerificationCooldown
+		}
+	}
+
+	daily, err := q.CountPhoneVerificationsSince(ctx, query.CountPhoneVerificationsSinceParams{
+		MemberID:  memberID,
+		WindowSec: telVerifyDailyWindowSec,
+	})
+	if err != nil {
+		return err
+	}
+	if daily >= int64(s.telVerifyCfg.DailyLimit) {
+		return ErrVerificationLimitExceeded
+	}
+
+	return nil
+}
+
+// 숫자 인증번호 (crypto/rand, 앞자리 0 포함)
+func verificationCode(length int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", length, n), nil
+}
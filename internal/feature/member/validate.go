@@ -0,0 +1,57 @@
+package member
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 프로필 필드 길이 제한 (문자 수)
+const (
+	maxNameLength    = 50
+	minAddressLength = 2
+	maxAddressLength = 200
+)
+
+// 프로필 수정 요청 검증 (전달된 값은 앞뒤 공백 제거, 전화번호는 E.164 로 정규화)
+// - name 은 필수 컬럼이라 null 불가, 나머지는 null 로 삭제 가능
+func validateProfile(req *UpdateProfileRequest) error {
+	if req.Name.Set {
+		req.Name.Value = strings.TrimSpace(req.Name.Value)
+		if req.Name.Null || !ValidName(req.Name.Value) {
+			return ErrInvalidName
+		}
+	}
+
+	if req.Tel.Set && !req.Tel.Null {
+		tel, err := NormalizeTel(req.Tel.Value)
+		if err != nil {
+			return err
+		}
+		req.Tel.Value = tel
+	}
+
+	if req.Address.Set && !req.Address.Null {
+		req.Address.Value = strings.TrimSpace(req.Address.Value)
+		if !ValidAddress(req.Address.Value) {
+			return ErrInvalidAddress
+		}
+	}
+
+	return nil
+}
+
+// 이름 길이 확인 (앞뒤 공백 제거 후)
+func ValidName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxNameLength
+}
+
+// 주소 길이 및 제어 문자 확인 (한 줄 주소)
+func ValidAddress(address string) bool {
+	length := utf8.RuneCountInString(address)
+	if length < minAddressLength || length > maxAddressLength {
+		return false
+	}
+
+	return !strings.ContainsFunc(address, unicode.IsControl)
+}
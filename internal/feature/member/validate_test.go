@@ -0,0 +1,99 @@
+package member
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateProfileRequestOptional(t *testing.T) {
+	var req UpdateProfileRequest
+	if err := json.Unmarshal([]byte(`{"tel": null, "address": "서울시 강남구"}`), &req); err != nil {
+		t.Fatalf("요청 파싱 실패: %v", err)
+	}
+
+	// 없음
+	if req.Name.Set {
+		t.Errorf("없는 필드가 설정됨: name=%+v", req.Name)
+	}
+	// null
+	if !req.Tel.Set || !req.Tel.Null {
+		t.Errorf("null 필드 구분 실패: %+v", req.Tel)
+	}
+	// 값
+	if !req.Address.Set || req.Address.Null || req.Address.Value != "서울시 강남구" {
+		t.Errorf("값 필드 파싱 실패: %+v", req.Address)
+	}
+	if req.Empty() {
+		t.Error("변경 필드가 있는 요청이 빈 요청으로 판단됨")
+	}
+
+	var empty UpdateProfileRequest
+	if err := json.Unmarshal([]byte(`{}`), &empty); err != nil || !empty.Empty() {
+		t.Errorf("빈 요청 판단 실패: %v", err)
+	}
+}
+
+func TestValidateProfile(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		err  error
+	}{
+		{"정상", `{"name": " 홍길동 ", "tel": "010-1234-5678", "address": "서울시 강남구 테헤란로 1"}`, nil},
+		{"국가번호 전화번호", `{"tel": "+82 10 1234 5678"}`, nil},
+		{"전화번호 삭제", `{"tel": null}`, nil},
+		{"주소 삭제", `{"address": null}`, nil},
+		{"이름 null", `{"name": null}`, ErrInvalidName},
+		{"이름 공백", `{"name": "   "}`, ErrInvalidName},
+		{"전화번호 문자 포함", `{"tel": "010-1234-abcd"}`, ErrInvalidTel},
+		{"전화번호 자릿수 부족", `{"tel": "123-456"}`, ErrInvalidTel},
+		{"전화번호 연속 구분자", `{"tel": "010--1234-5678"}`, ErrInvalidTel},
+		{"주소 너무 짧음", `{"address": "a"}`, ErrInvalidAddress},
+		{"주소 제어 문자", `{"address": "서울시\n강남구"}`, ErrInvalidAddress},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var req UpdateProfileRequest
+			if err := json.Unmarshal([]byte(tc.body), &req); err != nil {
+				t.Fatalf("요청 파싱 실패: %v", err)
+			}
+			if err := validateProfile(&req); err != tc.err {
+				t.Errorf("검증 결과 불일치: %v, 기대: %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestNormalizeTel(t *testing.T) {
+	cases := []struct {
+		raw    string
+		want   string
+		mobile bool
+	}{
+		{"010-1234-5678", "+821012345678", true},
+		{"+82 10 1234 5678", "+821012345678", true},
+		{"+82 010-1234-5678", "+821012345678", true},
+		{"011-123-4567", "+82111234567", true},
+		{"02-123-4567", "+8221234567", false},
+		{"031 1234 5678", "+823112345678", false},
+		{"+1 415 555 2671", "+14155552671", true},
+	}
+
+	for _, tc := range cases {
+		got, err := NormalizeTel(tc.raw)
+		if err != nil || got != tc.want {
+			t.Errorf("NormalizeTel(%q) = %q, %v, 기대: %q", tc.raw, got, err, tc.want)
+			continue
+		}
+		if isMobileTel(got) != tc.mobile {
+			t.Errorf("isMobileTel(%q) 기대: %v", got, tc.mobile)
+		}
+	}
+
+	for _, raw := range []string{"1588-1234", "010-123", "+0 123 4567 890", "010-1234-5678-9999", "+82 1 2345"} {
+		if _, err := NormalizeTel(raw); err != ErrInvalidTel {
+			t.Errorf("%q 는 거부되어야 함: %v", raw, err)
+		}
+	}
+}
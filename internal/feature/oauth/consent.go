@@ -0,0 +1,60 @@
+package oauth
+
+import (
+	"bytes"
+	"html/template"
+)
+
+// scope 설명 (동의 화면 표시용)
+var scopeDescriptions = map[string]string{
+	ScopeOpenID:        "로그인 식별자",
+	ScopeProfile:       "이름, 프로필 이미지",
+	ScopeEmail:         "이메일 주소",
+	ScopeOfflineAccess: "로그아웃 전까지 계속 접근",
+}
+
+var consentTemplate = template.Must(template.New("consent").Funcs(template.FuncMap{
+	"describe": func(scope string) string {
+		if desc, ok := scopeDescriptions[scope]; ok {
+			return desc
+		}
+		return scope
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>접근 권한 동의</title>
+</head>
+<body>
+<h1>{{.ClientName}}</h1>
+<p>{{.ClientName}} 에서 다음 정보에 접근하려고 합니다.</p>
+<ul>
+{{range .Scopes}}<li>{{describe .}}</li>
+{{end}}</ul>
+<form method="post">
+<input type="hidden" name="response_type" value="{{.Request.ResponseType}}">
+<input type="hidden" name="client_id" value="{{.Request.ClientID}}">
+<input type="hidden" name="redirect_uri" value="{{.Request.RedirectURI}}">
+<input type="hidden" name="scope" value="{{.Request.Scope}}">
+<input type="hidden" name="state" value="{{.Request.State}}">
+<input type="hidden" name="nonce" value="{{.Request.Nonce}}">
+<input type="hidden" name="code_challenge" value="{{.Request.CodeChallenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Request.CodeChallengeMethod}}">
+<input type="hidden" name="csrf_token" value="{{.CsrfToken}}">
+<button type="submit" name="decision" value="allow">동의</button>
+<button type="submit" name="decision" value="deny">거부</button>
+</form>
+</body>
+</html>
+`))
+
+// 동의 화면 HTML 생성
+func renderConsent(view *consentView) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := consentTemplate.Execute(&buf, view); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,87 @@
+package oauth
+
+// OAuth / OIDC 엔드포인트는 RFC 형식(snake_case)을 따르므로 공통 응답 포맷을 사용하지 않음
+
+// 인가 요청 DTO (GET /authorize 쿼리, POST /authorize 폼)
+type AuthorizeRequest struct {
+	ResponseType        string `query:"response_type" form:"response_type"`
+	ClientID            string `query:"client_id" form:"client_id"`
+	RedirectURI         string `query:"redirect_uri" form:"redirect_uri"`
+	Scope               string `query:"scope" form:"scope"`
+	State               string `query:"state" form:"state"`
+	Nonce               string `query:"nonce" form:"nonce"`
+	CodeChallenge       string `query:"code_challenge" form:"code_challenge"`
+	CodeChallengeMethod string `query:"code_challenge_method" form:"code_challenge_method"`
+	Prompt              string `query:"prompt" form:"prompt"`
+}
+
+// 토큰 요청 DTO (application/x-www-form-urlencoded)
+type TokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+// 토큰 응답 DTO (RFC 6749 5.1)
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// 에러 응답 DTO (RFC 6749 5.2)
+type ErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// UserInfo 응답 DTO (OIDC Core 5.3, scope 에 따라 필드 포함)
+type UserInfoResponse struct {
+	Sub     string `json:"sub"`
+	Name    string `json:"name,omitempty"`
+	Picture string `json:"picture,omitempty"` // 프로필 이미지 서명 URL (medium)
+	Email   string `json:"email,omitempty"`
+}
+
+// Discovery 문서 DTO (OIDC Discovery 3)
+type DiscoveryResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+}
+
+// 동의 화면 렌더링 데이터
+type consentView struct {
+	ClientName string
+	Scopes     []string
+	Request    AuthorizeRequest
+	CsrfToken  string
+}
+
+// 등록된 OAuth 클라이언트 (client_secret 해시는 서비스 밖으로 노출하지 않음)
+type Client struct {
+	ID           string
+	Name         string
+	Confidential bool
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+}
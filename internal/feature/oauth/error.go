@@ -0,0 +1,57 @@
+package oauth
+
+import (
+	"errors"
+	"slices"
+)
+
+// OAuth 2.0 / OIDC 에러 코드 (RFC 6749 5.2, OIDC Core 3.1.2.6)
+// 응답 error 필드에 그대로 사용하므로 RFC 표기(소문자)를 따름
+var (
+	// 필수 파라미터 누락 또는 형식 오류
+	ErrInvalidRequest = errors.New("invalid_request")
+
+	// 클라이언트 인증 실패 또는 등록되지 않은 클라이언트
+	ErrInvalidClient = errors.New("invalid_client")
+
+	// 인가 코드 / refresh 토큰 / PKCE 검증 실패
+	ErrInvalidGrant = errors.New("invalid_grant")
+
+	// 클라이언트에 허용되지 않은 grant
+	ErrUnauthorizedClient = errors.New("unauthorized_client")
+
+	// 지원하지 않는 grant_type
+	ErrUnsupportedGrantType = errors.New("unsupported_grant_type")
+
+	// 지원하지 않는 response_type
+	ErrUnsupportedResponseType = errors.New("unsupported_response_type")
+
+	// 허용되지 않은 scope
+	ErrInvalidScope = errors.New("invalid_scope")
+
+	// 사용자가 동의 거부
+	ErrAccessDenied = errors.New("access_denied")
+
+	// prompt=none 인데 로그인 필요
+	ErrLoginRequired = errors.New("login_required")
+
+	// prompt=none 인데 동의 필요
+	ErrConsentRequired = errors.New("consent_required")
+
+	// 등록되지 않은 redirect_uri (리다이렉트 불가)
+	ErrInvalidRedirectURI = errors.New("invalid_redirect_uri")
+
+	// access 토큰 검증 실패 (userinfo)
+	ErrInvalidToken = errors.New("invalid_token")
+)
+
+var oauthErrors = []error{
+	ErrInvalidRequest, ErrInvalidClient, ErrInvalidGrant, ErrUnauthorizedClient,
+	ErrUnsupportedGrantType, ErrUnsupportedResponseType, ErrInvalidScope, ErrAccessDenied,
+	ErrLoginRequired, ErrConsentRequired, ErrInvalidRedirectURI, ErrInvalidToken,
+}
+
+// 정의된 OAuth 에러 여부 (그 외는 server_error)
+func isOAuthError(err error) bool {
+	return slices.Contains(oauthErrors, err)
+}
@@ -0,0 +1,267 @@
+package oauth
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"study/internal/feature/auth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Handler
+// - 브라우저 리다이렉트 / RFC 형식 응답을 사용하므로 공통 응답 포맷을 사용하지 않음
+type OAuthHandler struct {
+	service       *OAuthService
+	cookieService *auth.CookieService
+}
+
+func NewOAuthHandler(service *OAuthService, cookieService *auth.CookieService) *OAuthHandler {
+	return &OAuthHandler{service: service, cookieService: cookieService}
+}
+
+// 인가 요청 (로그인 확인 → 동의 확인 → 인가 코드 리다이렉트)
+func (h *OAuthHandler) Authorize(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req AuthorizeRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errorJSON(c, fiber.StatusBadRequest, ErrInvalidRequest)
+	}
+
+	// 클라이언트 / redirect_uri 검증 실패는 리다이렉트하지 않음
+	client, err := h.service.Client(ctx, req.ClientID, req.RedirectURI)
+	if err != nil {
+		return errorJSON(c, fiber.StatusBadRequest, err)
+	}
+
+	if err := h.service.ValidateAuthorize(client, &req); err != nil {
+		return h.redirectError(c, &req, err)
+	}
+
+	// 로그인 세션 확인 (없으면 로그인 페이지로 이동 후 돌아옴)
+	memberID, err := h.service.Authenticate(ctx, h.cookieService.GetCookie(c))
+	if err == ErrLoginRequired {
+		if req.Prompt == "none" {
+			return h.redirectError(c, &req, err)
+		}
+		returnTo := h.service.Issuer() + c.OriginalURL()
+		return c.Redirect(h.service.LoginURL()+"?return_to="+url.QueryEscape(returnTo), fiber.StatusFound)
+	}
+	if err != nil {
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	// 이미 동의한 scope 면 동의 화면 생략
+	if req.Prompt != "consent" {
+		granted, err := h.service.ConsentGranted(ctx, memberID, client.ID, req.Scope)
+		if err != nil {
+			return errorJSON(c, fiber.StatusInternalServerError, err)
+		}
+		if granted {
+			return h.issueCode(c, memberID, client, &req)
+		}
+	}
+
+	if req.Prompt == "none" {
+		return h.redirectError(c, &req, ErrConsentRequired)
+	}
+
+	// 동의 화면 (폼 제출은 CSRF 토큰으로 검증)
+	csrfToken, err := h.cookieService.CsrfToken(c)
+	if err != nil {
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	page, err := renderConsent(&consentView{
+		ClientName: client.Name,
+		Scopes:     parseScope(req.Scope),
+		Request:    req,
+		CsrfToken:  csrfToken,
+	})
+	if err != nil {
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set(fiber.HeaderXFrameOptions, "DENY")
+	c.Type("html", "utf-8")
+	return c.Status(fiber.StatusOK).Send(page)
+}
+
+// 동의 화면 제출
+func (h *OAuthHandler) Consent(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req AuthorizeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errorJSON(c, fiber.StatusBadRequest, ErrInvalidRequest)
+	}
+
+	client, err := h.service.Client(ctx, req.ClientID, req.RedirectURI)
+	if err != nil {
+		return errorJSON(c, fiber.StatusBadRequest, err)
+	}
+
+	if !h.cookieService.VerifyCsrfValue(c, c.FormValue("csrf_token")) {
+		return errorJSON(c, fiber.StatusForbidden, ErrInvalidRequest)
+	}
+
+	if err := h.service.ValidateAuthorize(client, &req); err != nil {
+		return h.redirectError(c, &req, err)
+	}
+
+	memberID, err := h.service.Authenticate(ctx, h.cookieService.GetCookie(c))
+	if err != nil {
+		if err == ErrLoginRequired {
+			return h.redirectError(c, &req, err)
+		}
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	if c.FormValue("decision") != "allow" {
+		return h.redirectError(c, &req, ErrAccessDenied)
+	}
+
+	return h.issueCode(c, memberID, client, &req)
+}
+
+// 토큰 발급 (client_secret_basic / client_secret_post / 공개 클라이언트)
+func (h *OAuthHandler) Token(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req TokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errorJSON(c, fiber.StatusBadRequest, ErrInvalidRequest)
+	}
+
+	// Authorization: Basic 헤더가 있으면 우선
+	if id, secret, ok := basicCredentials(c.Get(fiber.HeaderAuthorization)); ok {
+		req.ClientID, req.ClientSecret = id, secret
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	c.Set(fiber.HeaderPragma, "no-cache")
+
+	resp, err := h.service.Token(ctx, &req)
+	if err == ErrInvalidClient {
+		c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+		return errorJSON(c, fiber.StatusUnauthorized, err)
+	}
+	if err != nil {
+		if isOAuthError(err) {
+			return errorJSON(c, fiber.StatusBadRequest, err)
+		}
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// UserInfo (인증 미들웨어 이후)
+func (h *OAuthHandler) UserInfo(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		c.Set(fiber.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+		return errorJSON(c, fiber.StatusUnauthorized, ErrInvalidToken)
+	}
+
+	resp, err := h.service.UserInfo(ctx, claims)
+	if err == ErrInvalidToken {
+		c.Set(fiber.HeaderWWWAuthenticate, `Bearer error="insufficient_scope", scope="openid"`)
+		return errorJSON(c, fiber.StatusForbidden, err)
+	}
+	if err != nil {
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// OpenID Provider 설정 문서
+func (h *OAuthHandler) Discovery(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(h.service.Discovery())
+}
+
+// ID 토큰 서명 공개키
+func (h *OAuthHandler) JWKS(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(h.service.JWKS())
+}
+
+// 인가 코드 발급 후 redirect_uri 로 이동
+func (h *OAuthHandler) issueCode(c *fiber.Ctx, memberID int64, client *Client, req *AuthorizeRequest) error {
+	code, err := h.service.Authorize(c.UserContext(), memberID, client, req)
+	if err != nil {
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+
+	return h.redirect(c, req, url.Values{"code": {code}})
+}
+
+// 인가 에러를 redirect_uri 로 전달 (RFC 6749 4.1.2.1)
+func (h *OAuthHandler) redirectError(c *fiber.Ctx, req *AuthorizeRequest, err error) error {
+	if !isOAuthError(err) {
+		return errorJSON(c, fiber.StatusInternalServerError, err)
+	}
+	return h.redirect(c, req, url.Values{"error": {err.Error()}})
+}
+
+// redirect_uri 에 응답 파라미터(state, iss 포함) 추가 후 이동
+func (h *OAuthHandler) redirect(c *fiber.Ctx, req *AuthorizeRequest, params url.Values) error {
+	target, err := url.Parse(req.RedirectURI)
+	if err != nil {
+		return errorJSON(c, fiber.StatusBadRequest, ErrInvalidRedirectURI)
+	}
+
+	values := target.Query()
+	for key, value := range params {
+		values[key] = value
+	}
+	if req.State != "" {
+		values.Set("state", req.State)
+	}
+	values.Set("iss", h.service.Issuer())
+	target.RawQuery = values.Encode()
+
+	return c.Redirect(target.String(), fiber.StatusFound)
+}
+
+// RFC 형식 에러 응답 (내부 오류는 server_error 로 숨김)
+func errorJSON(c *fiber.Ctx, status int, err error) error {
+	code := "server_error"
+	if isOAuthError(err) {
+		code = err.Error()
+	}
+	return c.Status(status).JSON(ErrorResponse{Error: code})
+}
+
+// Authorization: Basic 헤더에서 client_id / client_secret 추출 (RFC 6749 2.3.1)
+func basicCredentials(header string) (string, string, bool) {
+	const prefix = "Basic "
+	if !strings.HasPrefix(header, prefix) {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(header[len(prefix):])
+	if err != nil {
+		return "", "", false
+	}
+
+	id, secret, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", false
+	}
+
+	// 클라이언트 자격 증명은 form-urlencoded 후 Basic 인코딩됨
+	if id, err = url.QueryUnescape(id); err != nil {
+		return "", "", false
+	}
+	if secret, err = url.QueryUnescape(secret); err != nil {
+		return "", "", false
+	}
+
+	return id, secret, true
+}
@@ -0,0 +1,40 @@
+package oauth
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type OAuthRouter struct {
+	handler *OAuthHandler
+}
+
+func NewOAuthRouter(handler *OAuthHandler) *OAuthRouter {
+	return &OAuthRouter{handler: handler}
+}
+
+func (r *OAuthRouter) RegisterRoutes(
+	open fiber.Router,
+) {
+	api := open.Group("/oauth")
+
+	// 인가 요청은 refresh 쿠키로 로그인 세션 확인
+	api.Get("/authorize", r.handler.Authorize)
+	api.Post("/authorize", r.handler.Consent)
+	api.Post("/token", r.handler.Token)
+	api.Get("/jwks", r.handler.JWKS)
+}
+
+// OIDC Discovery (issuer 기준 고정 경로)
+func (r *OAuthRouter) RegisterWellKnownRoutes(
+	app fiber.Router,
+) {
+	app.Get("/.well-known/openid-configuration", r.handler.Discovery)
+}
+
+// userinfo 는 클라이언트 토큰 허용 그룹에 등록 (1st-party 라우트와 분리)
+func (r *OAuthRouter) RegisterUserInfoRoutes(
+	userinfo fiber.Router,
+) {
+	userinfo.Get("", r.handler.UserInfo)
+	userinfo.Post("", r.handler.UserInfo)
+}
@@ -0,0 +1,547 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"study/internal/config"
+	"study/internal/feature/auth"
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/internal/shared/profileimage"
+	"study/pkg/log"
+	"study/pkg/util"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 지원 scope
+const (
+	ScopeOpenID        = "openid"
+	ScopeProfile       = "profile"
+	ScopeEmail         = "email"
+	ScopeOfflineAccess = "offline_access"
+)
+
+// 지원 grant_type
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	GrantClientCredentials = "client_credentials"
+)
+
+// 엔드포인트 경로 (issuer 기준)
+const basePath = "/api/v1/oauth"
+
+// OAuthService
+// - OAuth 2.0 / OpenID Connect Provider 비즈니스 로직
+// - access / refresh 토큰은 JwtService, ID 토큰은 IDTokenSigner 로 발급
+type OAuthService struct {
+	jwt      *auth.JwtService
+	sessions *auth.SessionService
+	signer   *auth.IDTokenSigner
+	queries  *query.Queries
+	cfg      *config.OIDC
+	profiles *profileimage.Resolver
+}
+
+// 생성자
+func NewOAuthService(queries *query.Queries, jwt *auth.JwtService, sessions *auth.SessionService, signer *auth.IDTokenSigner, cfg *config.OIDC, profiles *profileimage.Resolver) *OAuthService {
+	return &OAuthService{queries: queries, jwt: jwt, sessions: sessions, signer: signer, cfg: cfg, profiles: profiles}
+}
+
+// 브라우저 로그인 세션 확인 (refresh 쿠키) 후 회원 ID 반환
+// 세션이 없거나 만료되면 ErrLoginRequired
+func (s *OAuthService) Authenticate(ctx context.Context, refreshToken string) (int64, error) {
+	if refreshToken == "" {
+		return 0, ErrLoginRequired
+	}
+
+	// OAuth 클라이언트에 발급한 refresh 토큰은 브라우저 세션이 아님
+	claims, err := s.jwt.VerifyRefreshToken(refreshToken)
+	if err != nil || claims.ClientID != "" {
+		return 0, ErrLoginRequired
+	}
+
+	if err := s.sessions.Touch(ctx, claims.SessionID, claims.MemberID); err != nil {
+		if err == auth.ErrSessionExpired {
+			return 0, ErrLoginRequired
+		}
+		return 0, err
+	}
+
+	return claims.MemberID, nil
+}
+
+// 클라이언트와 redirect_uri 확인 (실패 시 redirect_uri 로 돌려보낼 수 없음)
+func (s *OAuthService) Client(ctx context.Context, clientID string, redirectURI string) (*Client, error) {
+	client, err := s.queries.FindOAuthClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidClient
+		}
+		return nil, err
+	}
+
+	// 등록된 redirect_uri 와 정확히 일치해야 함
+	if !slices.Contains(client.RedirectUris, redirectURI) {
+		return nil, ErrInvalidRedirectURI
+	}
+
+	return toClient(client), nil
+}
+
+// 인가 요청 검증 (response_type, grant, scope, PKCE)
+func (s *OAuthService) ValidateAuthorize(client *Client, req *AuthorizeRequest) error {
+	if req.ResponseType != "code" {
+		return ErrUnsupportedResponseType
+	}
+
+	if !slices.Contains(client.GrantTypes, GrantAuthorizationCode) {
+		return ErrUnauthorizedClient
+	}
+
+	scopes := parseScope(req.Scope)
+	if len(scopes) == 0 || !containsAll(client.Scopes, scopes) {
+		return ErrInvalidScope
+	}
+
+	// 모든 클라이언트에 PKCE(S256) 필수
+	if req.CodeChallengeMethod != CodeChallengeS256 || !validCodeChallenge(req.CodeChallenge) {
+		return ErrInvalidRequest
+	}
+
+	return nil
+}
+
+// 요청 scope 에 대한 동의 여부
+func (s *OAuthService) ConsentGranted(ctx context.Context, memberID int64, clientID string, scope string) (bool, error) {
+	granted, err := s.queries.FindOAuthConsentScope(ctx, query.FindOAuthConsentScopeParams{
+		MemberID: memberID,
+		ClientID: clientID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return containsAll(parseScope(granted), parseScope(scope)), nil
+}
+
+// 동의 저장 후 인가 코드 발급
+func (s *OAuthService) Authorize(ctx context.Context, memberID int64, client *Client, req *AuthorizeRequest) (code string, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "Authorize")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	// 기존 동의 scope 에 요청 scope 추가
+	granted, err := s.queries.FindOAuthConsentScope(ctx, query.FindOAuthConsentScopeParams{
+		MemberID: memberID,
+		ClientID: client.ID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordServiceError(span, err)
+		return "", err
+	}
+
+	err = s.queries.UpsertOAuthConsent(ctx, query.UpsertOAuthConsentParams{
+		MemberID: memberID,
+		ClientID: client.ID,
+		Scope:    strings.Join(parseScope(granted+" "+req.Scope), " "),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return "", err
+	}
+
+	code, err = util.RandomString(32)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return "", err
+	}
+
+	err = s.queries.CreateAuthorizationCode(ctx, query.CreateAuthorizationCodeParams{
+		CodeHash:      hashCode(code),
+		ClientID:      client.ID,
+		MemberID:      memberID,
+		RedirectUri:   req.RedirectURI,
+		Scope:         strings.Join(parseScope(req.Scope), " "),
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		ExpireSec:     int32(s.cfg.CodeExpireSec),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return "", err
+	}
+
+	span.SetAttributes(
+		attribute.String("oauth.client_id", client.ID),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "인가 코드 발급", log.MapStr("clientId", client.ID))
+	return code, nil
+}
+
+// 토큰 발급 (grant_type 별 분기)
+func (s *OAuthService) Token(ctx context.Context, req *TokenRequest) (resp *TokenResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "OAuthToken")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("oauth.client_id", client.ID),
+		attribute.String("oauth.grant_type", req.GrantType),
+	)
+
+	switch req.GrantType {
+	case GrantAuthorizationCode, GrantRefreshToken, GrantClientCredentials:
+		if !slices.Contains(client.GrantTypes, req.GrantType) {
+			observability.RecordBusinessError(span, ErrUnauthorizedClient)
+			return nil, ErrUnauthorizedClient
+		}
+	default:
+		observability.RecordBusinessError(span, ErrUnsupportedGrantType)
+		return nil, ErrUnsupportedGrantType
+	}
+
+	switch req.GrantType {
+	case GrantAuthorizationCode:
+		resp, err = s.exchangeCode(ctx, client, req)
+	case GrantRefreshToken:
+		resp, err = s.refresh(ctx, client, req)
+	default:
+		resp, err = s.clientCredentials(ctx, client, req)
+	}
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	log.InfoCtx(ctx, "OAuth 토큰 발급", log.MapStr("clientId", client.ID), log.MapStr("grantType", req.GrantType))
+	return resp, nil
+}
+
+// authorization_code: 코드 1회 사용, redirect_uri / PKCE 확인
+func (s *OAuthService) exchangeCode(ctx context.Context, client *Client, req *TokenRequest) (*TokenResponse, error) {
+	if req.Code == "" || req.CodeVerifier == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	code, err := s.queries.ConsumeAuthorizationCode(ctx, hashCode(req.Code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidGrant
+		}
+		return nil, err
+	}
+
+	if code.ClientID != client.ID || code.RedirectUri != req.RedirectURI {
+		return nil, ErrInvalidGrant
+	}
+
+	if !verifyPKCE(req.CodeVerifier, code.CodeChallenge) {
+		return nil, ErrInvalidGrant
+	}
+
+	return s.issueTokens(ctx, client, code.MemberID, code.Scope, code.Nonce)
+}
+
+// refresh_token: 같은 클라이언트에 발급된 토큰만, scope 는 최초 범위 이내
+func (s *OAuthService) refresh(ctx context.Context, client *Client, req *TokenRequest) (*TokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	claims, err := s.jwt.VerifyRefreshToken(req.RefreshToken)
+	if err != nil || claims.ClientID != client.ID {
+		return nil, ErrInvalidGrant
+	}
+
+	// 비활성 / 승인 대기 회원은 토큰 재발급 불가
+	if err := s.checkMemberActive(ctx, claims.MemberID); err != nil {
+		return nil, err
+	}
+
+	scope := string(claims.Scope)
+	if req.Scope != "" {
+		if !containsAll(parseScope(scope), parseScope(req.Scope)) {
+			return nil, ErrInvalidScope
+		}
+		scope = strings.Join(parseScope(req.Scope), " ")
+	}
+
+	// 리프레쉬 세션 유휴/절대 만료 확인 (회원 로그아웃 시 함께 폐기)
+	if err := s.sessions.Touch(ctx, claims.SessionID, claims.MemberID); err != nil {
+		if err == auth.ErrSessionExpired {
+			return nil, ErrInvalidGrant
+		}
+		return nil, err
+	}
+
+	accessToken, err := s.jwt.GenerateClientAccessToken(ctx, claims.MemberID, client.ID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   auth.TokenSchemeBearer,
+		ExpiresIn:   s.jwt.AccessExpiresIn(),
+		Scope:       scope,
+	}, nil
+}
+
+// client_credentials: 기밀 클라이언트 전용, 회원 없는 토큰
+func (s *OAuthService) clientCredentials(ctx context.Context, client *Client, req *TokenRequest) (*TokenResponse, error) {
+	if !client.Confidential {
+		return nil, ErrUnauthorizedClient
+	}
+
+	scopes := parseScope(req.Scope)
+	if slices.Contains(scopes, ScopeOpenID) || slices.Contains(scopes, ScopeOfflineAccess) || !containsAll(client.Scopes, scopes) {
+		return nil, ErrInvalidScope
+	}
+	scope := strings.Join(scopes, " ")
+
+	accessToken, err := s.jwt.GenerateClientAccessToken(ctx, 0, client.ID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   auth.TokenSchemeBearer,
+		ExpiresIn:   s.jwt.AccessExpiresIn(),
+		Scope:       scope,
+	}, nil
+}
+
+// 회원 토큰 발급 (offline_access → refresh 토큰, openid → ID 토큰)
+func (s *OAuthService) issueTokens(ctx context.Context, client *Client, memberID int64, scope string, nonce string) (*TokenResponse, error) {
+	member, err := s.queries.FindMemberByID(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidGrant
+		}
+		return nil, err
+	}
+
+	// 인가 후 비활성화된 회원은 코드 교환 불가
+	if member.Status != model.StatusActive {
+		return nil, ErrInvalidGrant
+	}
+
+	accessToken, err := s.jwt.GenerateClientAccessToken(ctx, memberID, client.ID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   auth.TokenSchemeBearer,
+		ExpiresIn:   s.jwt.AccessExpiresIn(),
+		Scope:       scope,
+	}
+
+	scopes := parseScope(scope)
+
+	if slices.Contains(scopes, ScopeOfflineAccess) && slices.Contains(client.GrantTypes, GrantRefreshToken) {
+		sessionID, err := s.sessions.Create(ctx, memberID)
+		if err != nil {
+			return nil, err
+		}
+		if resp.RefreshToken, err = s.jwt.GenerateClientRefreshToken(memberID, sessionID, client.ID, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	if slices.Contains(scopes, ScopeOpenID) {
+		claims := auth.IDTokenClaims{
+			Nonce:           nonce,
+			AccessTokenHash: accessTokenHalfHash(accessToken),
+		}
+		if slices.Contains(scopes, ScopeEmail) {
+			claims.Email = member.Email
+		}
+		if slices.Contains(scopes, ScopeProfile) {
+			claims.Name = member.Name
+		}
+
+		if resp.IDToken, err = s.signer.Sign(memberID, client.ID, claims); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// 회원 상태 확인 (ACTIVE 외에는 invalid_grant)
+func (s *OAuthService) checkMemberActive(ctx context.Context, memberID int64) error {
+	member, err := s.queries.FindMemberByID(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidGrant
+		}
+		return err
+	}
+
+	if member.Status != model.StatusActive {
+		return ErrInvalidGrant
+	}
+	return nil
+}
+
+// 클라이언트 인증 (기밀: client_secret 필수 / 공개: client_secret 없음)
+func (s *OAuthService) authenticateClient(ctx context.Context, clientID string, secret string) (*Client, error) {
+	if clientID == "" {
+		return nil, ErrInvalidClient
+	}
+
+	client, err := s.queries.FindOAuthClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidClient
+		}
+		return nil, err
+	}
+
+	if !client.ClientSecret.Valid {
+		if secret != "" {
+			return nil, ErrInvalidClient
+		}
+		return toClient(client), nil
+	}
+
+	if secret == "" || util.VerifyHashString(secret, client.ClientSecret.String) != nil {
+		return nil, ErrInvalidClient
+	}
+
+	return toClient(client), nil
+}
+
+// UserInfo (openid scope 필요, scope 에 따라 클레임 제공)
+func (s *OAuthService) UserInfo(ctx context.Context, claims *auth.Claims) (resp *UserInfoResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "UserInfo")
+	defer observability.EndSpanWithLatency(span, start, 30)
+
+	scopes := parseScope(string(claims.Scope))
+	if claims.ClientID == "" || !slices.Contains(scopes, ScopeOpenID) {
+		observability.RecordBusinessError(span, ErrInvalidToken)
+		return nil, ErrInvalidToken
+	}
+
+	member, err := s.queries.FindMemberByID(ctx, claims.MemberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	resp = &UserInfoResponse{Sub: strconv.FormatInt(member.MemberID, 10)}
+	if slices.Contains(scopes, ScopeProfile) {
+		resp.Name = member.Name
+
+		// picture 는 저장 경로가 아닌 서명 URL (medium)
+		image, err := s.profiles.Resolve(ctx, member.MemberID, member.Profile)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		if image != nil {
+			resp.Picture = image.Medium
+		}
+	}
+	if slices.Contains(scopes, ScopeEmail) {
+		resp.Email = member.Email
+	}
+
+	span.SetAttributes(
+		attribute.String("oauth.client_id", claims.ClientID),
+		attribute.Int64("member.id", member.MemberID),
+	)
+
+	return resp, nil
+}
+
+// Discovery 문서
+func (s *OAuthService) Discovery() *DiscoveryResponse {
+	return &DiscoveryResponse{
+		Issuer:                            s.cfg.Issuer,
+		AuthorizationEndpoint:             s.cfg.Issuer + basePath + "/authorize",
+		TokenEndpoint:                     s.cfg.Issuer + basePath + "/token",
+		UserInfoEndpoint:                  s.cfg.Issuer + basePath + "/userinfo",
+		JwksURI:                           s.cfg.Issuer + basePath + "/jwks",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{GrantAuthorizationCode, GrantRefreshToken, GrantClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{s.signer.Algorithm()},
+		ScopesSupported:                   []string{ScopeOpenID, ScopeProfile, ScopeEmail, ScopeOfflineAccess},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "nonce", "name", "picture", "email"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{CodeChallengeS256},
+	}
+}
+
+// ID 토큰 검증 공개키
+func (s *OAuthService) JWKS() auth.JWKSet {
+	return s.signer.JWKS()
+}
+
+// 로그인 페이지 주소 (인가 요청 URL 을 return_to 로 전달)
+func (s *OAuthService) LoginURL() string {
+	return s.cfg.LoginURL
+}
+
+// 인가 응답 iss 파라미터 (RFC 9207)
+func (s *OAuthService) Issuer() string {
+	return s.cfg.Issuer
+}
+
+// query 모델 → 클라이언트
+func toClient(c query.OauthClient) *Client {
+	return &Client{
+		ID:           c.ClientID,
+		Name:         c.Name,
+		Confidential: c.ClientSecret.Valid,
+		RedirectURIs: c.RedirectUris,
+		GrantTypes:   c.GrantTypes,
+		Scopes:       c.Scopes,
+	}
+}
+
+// 인가 코드는 해시로 저장
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// ID 토큰 at_hash (access 토큰 SHA-256 앞 절반의 base64url)
+func accessTokenHalfHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+}
+
+// OAuth 에러는 비즈니스 에러, 그 외는 서비스 에러로 기록
+func recordError(span trace.Span, err error) {
+	if isOAuthError(err) {
+		observability.RecordBusinessError(span, err)
+		return
+	}
+	observability.RecordServiceError(span, err)
+}
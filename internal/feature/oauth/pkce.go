@@ -0,0 +1,67 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"slices"
+	"strings"
+)
+
+// PKCE (RFC 7636) - S256 만 허용
+const CodeChallengeS256 = "S256"
+
+// code_verifier 형식 검증 (43~128자, unreserved 문자)
+func validCodeVerifier(verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+
+	for _, ch := range verifier {
+		switch {
+		case ch >= 'A' && ch <= 'Z', ch >= 'a' && ch <= 'z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '.', ch == '_', ch == '~':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// code_challenge 형식 검증 (SHA-256 의 base64url, 43자)
+func validCodeChallenge(challenge string) bool {
+	decoded, err := base64.RawURLEncoding.DecodeString(challenge)
+	return err == nil && len(decoded) == sha256.Size
+}
+
+// code_verifier 와 저장된 code_challenge 비교
+func verifyPKCE(verifier string, challenge string) bool {
+	if !validCodeVerifier(verifier) {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// scope 문자열 → 중복 제거된 목록 (순서 유지)
+func parseScope(scope string) []string {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// 요청 scope 가 모두 허용 목록에 포함되는지
+func containsAll(allowed []string, requested []string) bool {
+	for _, s := range requested {
+		if !slices.Contains(allowed, s) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package oauth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerifyPKCE(t *testing.T) {
+	const (
+		verifier  = "dBjftJeZ4CVP-mJ0qOD3Vk6VnpBxNEUk7sLbRuafHa8"
+		challenge = "b-LoFmPZszS-sboHUsng6u8ew8aTrbKaX8qRBgs7npY"
+	)
+
+	if !validCodeChallenge(challenge) {
+		t.Fatal("정상 code_challenge 거부됨")
+	}
+	if !verifyPKCE(verifier, challenge) {
+		t.Fatal("정상 code_verifier 거부됨")
+	}
+
+	tests := []struct {
+		name     string
+		verifier string
+	}{
+		{"다른 verifier", strings.Repeat("a", 43)},
+		{"길이 부족", verifier[:42]},
+		{"길이 초과", strings.Repeat("a", 129)},
+		{"허용되지 않은 문자", verifier[:42] + "+"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if verifyPKCE(tt.verifier, challenge) {
+				t.Errorf("잘못된 code_verifier 허용됨: %s", tt.verifier)
+			}
+		})
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	scopes := parseScope("openid  profile openid email")
+	if strings.Join(scopes, " ") != "openid profile email" {
+		t.Errorf("scope 파싱 결과 불일치: %v", scopes)
+	}
+
+	if !containsAll([]string{"openid", "profile", "email"}, []string{"email", "openid"}) {
+		t.Error("허용된 scope 거부됨")
+	}
+	if containsAll([]string{"openid"}, []string{"openid", "email"}) {
+		t.Error("허용되지 않은 scope 허용됨")
+	}
+}
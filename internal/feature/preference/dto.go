@@ -0,0 +1,9 @@
+package preference
+
+import "time"
+
+// 설정 조회 / 수정 응답 (기본값 포함 전체 설정)
+type PreferencesResponse struct {
+	Preferences map[string]any `json:"preferences"`
+	UpdatedAt   *time.Time     `json:"updatedAt"`
+}
@@ -0,0 +1,15 @@
+package preference
+
+import "errors"
+
+// 서비스 에러
+var (
+	// 등록되지 않은 설정 키
+	ErrUnknownPreference = errors.New("UNKNOWN_PREFERENCE")
+
+	// 설정 값 타입 / 허용 값 오류
+	ErrInvalidPreference = errors.New("INVALID_PREFERENCE")
+
+	// 회원 없음 (비활성 회원 포함)
+	ErrMemberNotFound = errors.New("MEMBER_NOT_FOUND")
+)
@@ -0,0 +1,72 @@
+package preference
+
+import (
+	"encoding/json"
+
+	"study/internal/feature/auth"
+	"study/internal/shared/errorx"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Handler
+type PreferenceHandler struct {
+	service *PreferenceService
+}
+
+func NewPreferenceHandler(service *PreferenceService) *PreferenceHandler {
+	return &PreferenceHandler{service: service}
+}
+
+// 내 설정 조회 (기본값 포함)
+func (h *PreferenceHandler) GetMe(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	prefs, err := h.service.GetPreferences(ctx, claims.MemberID)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "설정 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("설정 조회 성공", prefs))
+}
+
+// 내 설정 수정 (부분 수정, { "key": value | null })
+func (h *PreferenceHandler) UpdateMe(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	claims := auth.ClaimsFrom(c)
+	if claims == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(response.Error(auth.ErrTokenInvalid.Error(), "인증 정보 없음", nil))
+	}
+
+	var req map[string]json.RawMessage
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	prefs, err := h.service.UpdatePreferences(ctx, claims.MemberID, req)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(err.Error(), "설정 수정 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("설정 수정 성공", prefs))
+}
+
+// 서비스 에러 → HTTP 상태
+func errorStatus(err error) int {
+	switch err {
+	case ErrMemberNotFound:
+		return fiber.StatusNotFound
+	case ErrUnknownPreference, ErrInvalidPreference:
+		return fiber.StatusBadRequest
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
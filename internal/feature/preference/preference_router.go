@@ -0,0 +1,22 @@
+package preference
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type PreferenceRouter struct {
+	handler *PreferenceHandler
+}
+
+func NewPreferenceRouter(handler *PreferenceHandler) *PreferenceRouter {
+	return &PreferenceRouter{handler: handler}
+}
+
+func (r *PreferenceRouter) RegisterAuthRoutes(
+	auth fiber.Router,
+) {
+	preferences := auth.Group("/members/me/preferences")
+
+	preferences.Get("", r.handler.GetMe)
+	preferences.Patch("", r.handler.UpdateMe)
+}
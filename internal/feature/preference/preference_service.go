@@ -0,0 +1,141 @@
+package preference
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// PreferenceService
+// - 회원 본인 설정 조회/수정 + 다른 기능에서 쓰는 설정 조회 (Get, AllowsNotification, AllowsMarketing 등)
+type PreferenceService struct {
+	queries  *query.Queries
+	registry *Registry
+}
+
+// 생성자
+func NewPreferenceService(queries *query.Queries, registry *Registry) *PreferenceService {
+	return &PreferenceService{
+		queries:  queries,
+		registry: registry,
+	}
+}
+
+// 회원 설정 조회 (저장 값이 없으면 기본값)
+func (s *PreferenceService) Get(ctx context.Context, memberID int64) (Preferences, error) {
+	row, err := s.queries.FindMemberPreferences(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return s.registry.Resolve(nil), nil
+	}
+	if err != nil {
+		return Preferences{}, err
+	}
+	return s.registry.Resolve(row.Preferences), nil
+}
+
+// 알림 수신 여부 (채널 미선택 시 거부)
+func (s *PreferenceService) AllowsNotification(ctx context.Context, memberID int64, channel string) (bool, error) {
+	prefs, err := s.Get(ctx, memberID)
+	if err != nil {
+		return false, err
+	}
+	return prefs.Contains(KeyNotificationChannels, channel), nil
+}
+
+// 마케팅 수신 여부 (수신 동의 + 채널 선택 모두 필요)
+func (s *PreferenceService) AllowsMarketing(ctx context.Context, memberID int64, channel string) (bool, error) {
+	prefs, err := s.Get(ctx, memberID)
+	if err != nil {
+		return false, err
+	}
+	return prefs.AllowsMarketing(channel), nil
+}
+
+// 내 설정 조회
+func (s *PreferenceService) GetPreferences(ctx context.Context, memberID int64) (resp *PreferencesResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "GetPreferences")
+	defer observability.EndSpanWithLatency(span, start, 30)
+
+	row, err := s.queries.FindMemberPreferences(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &PreferencesResponse{Preferences: s.registry.Resolve(nil).Map()}, nil
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int64("member.id", memberID))
+
+	return &PreferencesResponse{
+		Preferences: s.registry.Resolve(row.Preferences).Map(),
+		UpdatedAt:   mapper.TimePtr(row.UpdatedAt),
+	}, nil
+}
+
+// 내 설정 수정 (전달한 키만 변경, null 은 기본값으로 초기화)
+func (s *PreferenceService) UpdatePreferences(ctx context.Context, memberID int64, patch map[string]json.RawMessage) (resp *PreferencesResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "UpdatePreferences")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	set, unset, err := s.registry.Diff(patch)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// 변경할 설정이 없으면 현재 설정 반환 (updated_at 유지)
+	if len(patch) == 0 {
+		return s.GetPreferences(ctx, memberID)
+	}
+
+	// 활성 회원만 수정
+	m, err := s.queries.FindMemberByID(ctx, memberID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && m.Status != model.StatusActive) {
+		observability.RecordBusinessError(span, ErrMemberNotFound)
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	values, err := json.Marshal(set)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	if unset == nil {
+		unset = []string{}
+	}
+
+	row, err := s.queries.UpsertMemberPreferences(ctx, query.UpsertMemberPreferencesParams{
+		MemberID:  memberID,
+		SetValues: values,
+		UnsetKeys: unset,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("member.action", "update_preferences"),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "회원 설정 수정", log.MapInt64("memberId", memberID), log.MapInt("changed", len(patch)))
+	return &PreferencesResponse{
+		Preferences: s.registry.Resolve(row.Preferences).Map(),
+		UpdatedAt:   mapper.TimePtr(row.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,246 @@
+package preference
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// 설정 값 타입
+type Kind string
+
+const (
+	KindBool      Kind = "bool"
+	KindString    Kind = "string"
+	KindStringSet Kind = "stringSet" // 중복 없는 문자열 목록 (Enum 순서로 정렬)
+)
+
+// 설정 정의
+type Definition struct {
+	Key      string
+	Kind     Kind
+	Default  any                      // bool | string | []string
+	Enum     []string                 // string / stringSet 허용 값 (비어 있으면 제한 없음)
+	Validate func(value string) error // string 추가 검증
+}
+
+// 설정 스키마 레지스트리
+// - 라우터 구성 시점에 등록하고 이후에는 읽기만 함
+// - 저장 값이 없거나 스키마와 맞지 않으면 기본값 사용 (정의 변경 시 마이그레이션 불필요)
+type Registry struct {
+	defs  map[string]Definition
+	order []string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{defs: map[string]Definition{}}
+}
+
+// 설정 등록 (중복 키 / 잘못된 기본값은 설정 오류라 panic)
+func (r *Registry) Register(def Definition) {
+	if def.Key == "" {
+		panic("preference: 빈 설정 키")
+	}
+	if _, ok := r.defs[def.Key]; ok {
+		panic(fmt.Sprintf("preference: 중복 설정 키 %q", def.Key))
+	}
+
+	value, err := def.normalize(def.Default)
+	if err != nil {
+		panic(fmt.Sprintf("preference: %q 기본값 오류: %v", def.Key, err))
+	}
+	def.Default = value
+
+	r.defs[def.Key] = def
+	r.order = append(r.order, def.Key)
+}
+
+// 등록된 설정 키 (등록 순서)
+func (r *Registry) Keys() []string {
+	return slices.Clone(r.order)
+}
+
+// 저장 값 → 전체 설정 (누락 / 잘못된 값은 기본값)
+func (r *Registry) Resolve(stored []byte) Preferences {
+	raw := map[string]json.RawMessage{}
+	if len(stored) > 0 {
+		// 깨진 JSON 은 전부 기본값
+		_ = json.Unmarshal(stored, &raw)
+	}
+
+	values := make(map[string]any, len(r.order))
+	for _, key := range r.order {
+		def := r.defs[key]
+		values[key] = def.Default
+
+		if data, ok := raw[key]; ok {
+			if value, err := def.decode(data); err == nil {
+				values[key] = value
+			}
+		}
+	}
+
+	return Preferences{values: values}
+}
+
+// 부분 수정 요청 검증
+// - null 또는 기본값과 같은 값은 초기화 (저장 값 삭제)
+// - 등록되지 않은 키 / 타입, 허용 값 오류는 전체 거부
+func (r *Registry) Diff(patch map[string]json.RawMessage) (set map[string]any, unset []string, err error) {
+	set = map[string]any{}
+	for key, data := range patch {
+		def, ok := r.defs[key]
+		if !ok {
+			return nil, nil, ErrUnknownPreference
+		}
+
+		if isNull(data) {
+			unset = append(unset, key)
+			continue
+		}
+
+		value, err := def.decode(data)
+		if err != nil {
+			return nil, nil, ErrInvalidPreference
+		}
+		if reflect.DeepEqual(value, def.Default) {
+			unset = append(unset, key)
+			continue
+		}
+		set[key] = value
+	}
+
+	slices.Sort(unset)
+	return set, unset, nil
+}
+
+// JSON 값 → 정의 타입 값
+func (d Definition) decode(data json.RawMessage) (any, error) {
+	var value any
+	switch d.Kind {
+	case KindBool:
+		var v bool
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		value = v
+	case KindString:
+		var v string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		value = v
+	case KindStringSet:
+		var v []string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		value = v
+	default:
+		return nil, fmt.Errorf("지원하지 않는 타입 %q", d.Kind)
+	}
+
+	return d.normalize(value)
+}
+
+// 타입 / 허용 값 검증, stringSet 은 중복 제거 후 정렬
+func (d Definition) normalize(value any) (any, error) {
+	switch d.Kind {
+	case KindBool:
+		v, ok := value.(bool)
+		if !ok {
+			return nil, ErrInvalidPreference
+		}
+		return v, nil
+	case KindString:
+		v, ok := value.(string)
+		if !ok || !d.allowed(v) {
+			return nil, ErrInvalidPreference
+		}
+		if d.Validate != nil {
+			if err := d.Validate(v); err != nil {
+				return nil, ErrInvalidPreference
+			}
+		}
+		return v, nil
+	case KindStringSet:
+		v, ok := value.([]string)
+		if !ok {
+			return nil, ErrInvalidPreference
+		}
+		set := make([]string, 0, len(v))
+		for _, item := range v {
+			if !d.allowed(item) {
+				return nil, ErrInvalidPreference
+			}
+			if !slices.Contains(set, item) {
+				set = append(set, item)
+			}
+		}
+		slices.SortFunc(set, d.compare)
+		return set, nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 타입 %q", d.Kind)
+	}
+}
+
+func (d Definition) allowed(value string) bool {
+	return len(d.Enum) == 0 || slices.Contains(d.Enum, value)
+}
+
+// Enum 순서 (Enum 이 없으면 사전순)
+func (d Definition) compare(a, b string) int {
+	if len(d.Enum) == 0 {
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+		return 0
+	}
+	return slices.Index(d.Enum, a) - slices.Index(d.Enum, b)
+}
+
+func isNull(data json.RawMessage) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
+// 회원 설정 값 (기본값 포함, 읽기 전용)
+type Preferences struct {
+	values map[string]any
+}
+
+func (p Preferences) Bool(key string) bool {
+	v, _ := p.values[key].(bool)
+	return v
+}
+
+func (p Preferences) String(key string) string {
+	v, _ := p.values[key].(string)
+	return v
+}
+
+func (p Preferences) Strings(key string) []string {
+	v, _ := p.values[key].([]string)
+	return slices.Clone(v)
+}
+
+// stringSet 설정에 값 포함 여부
+func (p Preferences) Contains(key string, value string) bool {
+	v, _ := p.values[key].([]string)
+	return slices.Contains(v, value)
+}
+
+// 응답용 전체 값
+func (p Preferences) Map() map[string]any {
+	values := make(map[string]any, len(p.values))
+	for key, value := range p.values {
+		if v, ok := value.([]string); ok {
+			value = slices.Clone(v)
+		}
+		values[key] = value
+	}
+	return values
+}
@@ -0,0 +1,116 @@
+package preference
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	// 저장 값 없음 → 기본값
+	prefs := r.Resolve(nil)
+	if prefs.String(KeyLocale) != "ko-KR" || prefs.String(KeyTimezone) != "Asia/Seoul" || prefs.Bool(KeyMarketingOptIn) {
+		t.Errorf("기본값 오류: %v", prefs.Map())
+	}
+	if !prefs.Contains(KeyNotificationChannels, ChannelEmail) || prefs.Contains(KeyNotificationChannels, ChannelSMS) {
+		t.Errorf("기본 알림 채널 오류: %v", prefs.Strings(KeyNotificationChannels))
+	}
+
+	// 잘못된 값 / 모르는 키는 무시
+	prefs = r.Resolve([]byte(`{"locale":"xx","timezone":"Europe/Paris","marketingOptIn":"yes","removed":1}`))
+	if prefs.String(KeyLocale) != "ko-KR" || prefs.String(KeyTimezone) != "Europe/Paris" || prefs.Bool(KeyMarketingOptIn) {
+		t.Errorf("저장 값 해석 오류: %v", prefs.Map())
+	}
+	if _, ok := prefs.Map()["removed"]; ok {
+		t.Error("등록되지 않은 키 노출")
+	}
+
+	// 깨진 JSON → 기본값
+	if r.Resolve([]byte(`{`)).String(KeyLocale) != "ko-KR" {
+		t.Error("깨진 JSON 처리 오류")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	patch := map[string]json.RawMessage{
+		KeyLocale:               json.RawMessage(`"en-US"`),
+		KeyTimezone:             json.RawMessage(`null`),
+		KeyMarketingOptIn:       json.RawMessage(`false`),
+		KeyNotificationChannels: json.RawMessage(`["push","email","push"]`),
+	}
+	set, unset, err := r.Diff(patch)
+	if err != nil {
+		t.Fatalf("Diff 실패: %v", err)
+	}
+
+	// 기본값과 같은 값 / null 은 초기화, stringSet 은 중복 제거 + Enum 순서
+	wantSet := map[string]any{
+		KeyLocale:               "en-US",
+		KeyNotificationChannels: []string{ChannelEmail, ChannelPush},
+	}
+	if !reflect.DeepEqual(set, wantSet) {
+		t.Errorf("set = %v, want %v", set, wantSet)
+	}
+	if want := []string{KeyMarketingOptIn, KeyTimezone}; !reflect.DeepEqual(unset, want) {
+		t.Errorf("unset = %v, want %v", unset, want)
+	}
+
+	tests := []struct {
+		name  string
+		patch map[string]json.RawMessage
+		want  error
+	}{
+		{"모르는 키", map[string]json.RawMessage{"unknown": json.RawMessage(`true`)}, ErrUnknownPreference},
+		{"타입 오류", map[string]json.RawMessage{KeyMarketingOptIn: json.RawMessage(`"true"`)}, ErrInvalidPreference},
+		{"허용 값 외", map[string]json.RawMessage{KeyLocale: json.RawMessage(`"fr-FR"`)}, ErrInvalidPreference},
+		{"없는 timezone", map[string]json.RawMessage{KeyTimezone: json.RawMessage(`"Mars/Base"`)}, ErrInvalidPreference},
+		{"서버 timezone", map[string]json.RawMessage{KeyTimezone: json.RawMessage(`"Local"`)}, ErrInvalidPreference},
+		{"허용 채널 외", map[string]json.RawMessage{KeyNotificationChannels: json.RawMessage(`["fax"]`)}, ErrInvalidPreference},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := r.Diff(tt.patch); err != tt.want {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterInvalidDefault(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("잘못된 기본값 등록 허용됨")
+		}
+	}()
+
+	r := NewRegistry()
+	r.Register(Definition{Key: "theme", Kind: KindString, Default: "blue", Enum: []string{"light", "dark"}})
+}
+
+func TestAllowsMarketing(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	cases := []struct {
+		name   string
+		stored string
+		want   bool
+	}{
+		{"기본값 (동의 안 함)", ``, false},
+		{"동의 + 이메일 채널", `{"marketingOptIn":true}`, true},
+		{"동의 + 이메일 채널 해제", `{"marketingOptIn":true,"notificationChannels":["sms"]}`, false},
+		{"채널만 선택", `{"notificationChannels":["email"]}`, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := r.Resolve([]byte(tc.stored)).AllowsMarketing(ChannelEmail); got != tc.want {
+				t.Errorf("마케팅 수신 여부: %v, 기대: %v", got, tc.want)
+			}
+		})
+	}
+}
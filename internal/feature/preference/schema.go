@@ -0,0 +1,70 @@
+package preference
+
+import (
+	"errors"
+	"time"
+
+	// 컨테이너에 zoneinfo 가 없어도 timezone 검증 가능
+	_ "time/tzdata"
+)
+
+// 설정 키
+const (
+	KeyLocale               = "locale"
+	KeyTimezone             = "timezone"
+	KeyMarketingOptIn       = "marketingOptIn"
+	KeyNotificationChannels = "notificationChannels"
+)
+
+// 알림 채널
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelPush  = "push"
+)
+
+// 마케팅 수신 여부 (수신 동의 + 채널 선택 모두 필요)
+func (p Preferences) AllowsMarketing(channel string) bool {
+	return p.Bool(KeyMarketingOptIn) && p.Contains(KeyNotificationChannels, channel)
+}
+
+// 기본 설정 스키마
+// - 새 설정은 여기(또는 기능별로 라우터에서 Register)에 추가, 마이그레이션 불필요
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+
+	r.Register(Definition{
+		Key:     KeyLocale,
+		Kind:    KindString,
+		Default: "ko-KR",
+		Enum:    []string{"ko-KR", "en-US", "ja-JP"},
+	})
+	r.Register(Definition{
+		Key:      KeyTimezone,
+		Kind:     KindString,
+		Default:  "Asia/Seoul",
+		Validate: validateTimezone,
+	})
+	r.Register(Definition{
+		Key:     KeyMarketingOptIn,
+		Kind:    KindBool,
+		Default: false,
+	})
+	r.Register(Definition{
+		Key:     KeyNotificationChannels,
+		Kind:    KindStringSet,
+		Default: []string{ChannelEmail},
+		Enum:    []string{ChannelEmail, ChannelSMS, ChannelPush},
+	})
+
+	return r
+}
+
+// IANA timezone 이름 (Local 은 서버 설정이라 제외)
+func validateTimezone(value string) error {
+	if value == "" || value == "Local" {
+		return errors.New("invalid timezone")
+	}
+	_, err := time.LoadLocation(value)
+	return err
+}
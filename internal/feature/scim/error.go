@@ -0,0 +1,76 @@
+package scim
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 서비스 에러
+var (
+	// 리소스 없음 (다른 테넌트 소유 포함)
+	ErrUserNotFound  = errors.New("USER_NOT_FOUND")
+	ErrGroupNotFound = errors.New("GROUP_NOT_FOUND")
+
+	// userName / externalId 중복
+	ErrUniqueness = errors.New("UNIQUENESS")
+
+	// 지원하지 않는 filter 식
+	ErrInvalidFilter = errors.New("INVALID_FILTER")
+
+	// 지원하지 않는 PATCH path
+	ErrInvalidPath = errors.New("INVALID_PATH")
+
+	// 값 형식 오류 / 필수값 누락
+	ErrInvalidValue = errors.New("INVALID_VALUE")
+
+	// 현재 상태에서 허용되지 않는 변경 (상태 전이 규칙 위반)
+	ErrMutability = errors.New("MUTABILITY")
+
+	// USER Group 구성원 제거 (USER 는 회원이면 항상 보유, 회원 비활성화는 User active 로)
+	ErrGroupImmutable = errors.New("GROUP_IMMUTABLE")
+
+	// 테넌트에 허용되지 않은 Group 변경 (allowAdmin 없이 ADMIN)
+	ErrGroupForbidden = errors.New("GROUP_FORBIDDEN")
+
+	// 요청 본문 형식 오류
+	ErrInvalidSyntax = errors.New("INVALID_SYNTAX")
+
+	// 테넌트 토큰 인증 실패
+	ErrUnauthorized = errors.New("UNAUTHORIZED")
+)
+
+// 에러별 HTTP 상태 / scimType (RFC 7644 3.12)
+var errorTypes = map[error]struct {
+	status   int
+	scimType string
+	detail   string
+}{
+	ErrUserNotFound:   {fiber.StatusNotFound, "", "User not found"},
+	ErrGroupNotFound:  {fiber.StatusNotFound, "", "Group not found"},
+	ErrUniqueness:     {fiber.StatusConflict, "uniqueness", "userName or externalId already exists"},
+	ErrInvalidFilter:  {fiber.StatusBadRequest, "invalidFilter", "Unsupported filter"},
+	ErrInvalidPath:    {fiber.StatusBadRequest, "invalidPath", "Unsupported path"},
+	ErrInvalidValue:   {fiber.StatusBadRequest, "invalidValue", "Invalid value"},
+	ErrMutability:     {fiber.StatusBadRequest, "mutability", "Status change not allowed"},
+	ErrGroupImmutable: {fiber.StatusBadRequest, "mutability", "USER group members cannot be removed"},
+	ErrGroupForbidden: {fiber.StatusForbidden, "", "Group not writable by this tenant"},
+	ErrInvalidSyntax:  {fiber.StatusBadRequest, "invalidSyntax", "Invalid request body"},
+	ErrUnauthorized:   {fiber.StatusUnauthorized, "", "Invalid bearer token"},
+}
+
+// SCIM 에러 응답 (공통 응답 포맷 대신 RFC 형식 사용, 내부 오류는 500)
+func Error(c *fiber.Ctx, err error) error {
+	status, scimType, detail := fiber.StatusInternalServerError, "", "Internal server error"
+	if t, ok := errorTypes[err]; ok {
+		status, scimType, detail = t.status, t.scimType, t.detail
+	}
+
+	return c.Status(status).JSON(ErrorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}, ContentType)
+}
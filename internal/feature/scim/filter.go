@@ -0,0 +1,106 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 목록 조회 filter 조건
+// - IdP 가 실제로 보내는 `attr eq "value"` 와 `and` 조합만 지원
+type userFilter struct {
+	UserName   *string
+	ExternalID *string
+	Active     *bool
+}
+
+// filter 식 파싱 (예: userName eq "a@b.com" and active eq true)
+func parseUserFilter(filter string) (*userFilter, error) {
+	result := &userFilter{}
+
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return result, nil
+	}
+
+	for _, expr := range splitAnd(filter) {
+		attr, value, err := parseEq(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(attr) {
+		case "username", "emails.value":
+			str, err := unquote(value)
+			if err != nil {
+				return nil, err
+			}
+			result.UserName = &str
+
+		case "externalid":
+			str, err := unquote(value)
+			if err != nil {
+				return nil, err
+			}
+			result.ExternalID = &str
+
+		case "active":
+			active, err := strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return nil, ErrInvalidFilter
+			}
+			result.Active = &active
+
+		default:
+			return nil, ErrInvalidFilter
+		}
+	}
+
+	return result, nil
+}
+
+// 따옴표 밖의 " and " 로 분리 (대소문자 무시)
+func splitAnd(filter string) []string {
+	var (
+		parts   []string
+		start   int
+		inQuote bool
+	)
+
+	for i := 0; i < len(filter); i++ {
+		switch {
+		case filter[i] == '\\' && inQuote:
+			i++
+		case filter[i] == '"':
+			inQuote = !inQuote
+		case !inQuote && i+5 <= len(filter) && strings.EqualFold(filter[i:i+5], " and "):
+			parts = append(parts, filter[start:i])
+			start = i + 5
+			i += 4
+		}
+	}
+
+	return append(parts, filter[start:])
+}
+
+// `attr eq value` 분리
+func parseEq(expr string) (string, string, error) {
+	fields := strings.SplitN(strings.TrimSpace(expr), " ", 3)
+	if len(fields) != 3 || !strings.EqualFold(fields[1], "eq") {
+		return "", "", ErrInvalidFilter
+	}
+
+	return fields[0], strings.TrimSpace(fields[2]), nil
+}
+
+// JSON 문자열 리터럴 해제
+func unquote(value string) (string, error) {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return "", ErrInvalidFilter
+	}
+
+	str, err := strconv.Unquote(value)
+	if err != nil {
+		return "", ErrInvalidFilter
+	}
+	return str, nil
+}
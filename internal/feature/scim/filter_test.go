@@ -0,0 +1,60 @@
+package scim
+
+import "testing"
+
+func TestParseUserFilter(t *testing.T) {
+	filter, err := parseUserFilter(`userName eq "a and b@example.com" AND active eq True and externalId eq "ext-1"`)
+	if err != nil {
+		t.Fatalf("정상 filter 거부됨: %v", err)
+	}
+	if filter.UserName == nil || *filter.UserName != "a and b@example.com" {
+		t.Errorf("userName 파싱 결과 불일치: %v", filter.UserName)
+	}
+	if filter.Active == nil || !*filter.Active {
+		t.Errorf("active 파싱 결과 불일치: %v", filter.Active)
+	}
+	if filter.ExternalID == nil || *filter.ExternalID != "ext-1" {
+		t.Errorf("externalId 파싱 결과 불일치: %v", filter.ExternalID)
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+	}{
+		{"지원하지 않는 연산자", `userName co "a"`},
+		{"지원하지 않는 속성", `title eq "a"`},
+		{"or 조합", `userName eq "a" or userName eq "b"`},
+		{"따옴표 없는 문자열", `userName eq a`},
+		{"잘못된 bool", `active eq yes`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseUserFilter(tt.filter); err != ErrInvalidFilter {
+				t.Errorf("잘못된 filter 허용됨: %s", tt.filter)
+			}
+		})
+	}
+}
+
+func TestApplyUserPatch(t *testing.T) {
+	state := &userState{UserName: "a@example.com", Name: "A", Active: true}
+
+	err := applyUserPatch(state, &PatchRequest{Operations: []PatchOperation{
+		{Op: "Replace", Path: "active", Value: []byte(`"False"`)},
+		{Op: "replace", Value: []byte(`{"displayName":"B","externalId":"ext-1"}`)},
+	}})
+	if err != nil {
+		t.Fatalf("정상 PATCH 거부됨: %v", err)
+	}
+	if state.Active || state.Name != "B" || state.ExternalID == nil || *state.ExternalID != "ext-1" {
+		t.Errorf("PATCH 적용 결과 불일치: %+v", state)
+	}
+
+	err = applyUserPatch(state, &PatchRequest{Operations: []PatchOperation{
+		{Op: "remove", Path: "userName"},
+	}})
+	if err != ErrInvalidPath {
+		t.Errorf("필수 속성 제거 허용됨: %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// PATCH 적용 대상 (User 의 변경 가능한 속성)
+type userState struct {
+	UserName   string
+	Name       string
+	Active     bool
+	ExternalID *string
+}
+
+// PATCH 연산 순서대로 적용 (RFC 7644 3.5.2)
+func applyUserPatch(state *userState, req *PatchRequest) error {
+	if len(req.Operations) == 0 {
+		return ErrInvalidSyntax
+	}
+
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if err := replaceUser(state, op.Path, op.Value); err != nil {
+				return err
+			}
+
+		case "remove":
+			if err := removeUser(state, op.Path); err != nil {
+				return err
+			}
+
+		default:
+			return ErrInvalidSyntax
+		}
+	}
+
+	return nil
+}
+
+// add / replace (path 가 없으면 value 는 속성 객체)
+func replaceUser(state *userState, path string, value json.RawMessage) error {
+	if path == "" {
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(value, &attrs); err != nil {
+			return ErrInvalidValue
+		}
+		for key, attr := range attrs {
+			if err := replaceUser(state, key, attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch strings.ToLower(path) {
+	case "active":
+		active, err := parseActive(value)
+		if err != nil {
+			return err
+		}
+		state.Active = active
+
+	case "username":
+		str, err := parseString(value)
+		if err != nil || str == "" {
+			return ErrInvalidValue
+		}
+		state.UserName = str
+
+	case "displayname", "name.formatted":
+		str, err := parseString(value)
+		if err != nil || str == "" {
+			return ErrInvalidValue
+		}
+		state.Name = str
+
+	case "name":
+		var name Name
+		if err := json.Unmarshal(value, &name); err != nil || name.Formatted == "" {
+			return ErrInvalidValue
+		}
+		state.Name = name.Formatted
+
+	case "externalid":
+		str, err := parseString(value)
+		if err != nil {
+			return ErrInvalidValue
+		}
+		state.ExternalID = &str
+
+	default:
+		return ErrInvalidPath
+	}
+
+	return nil
+}
+
+// remove (필수 속성은 제거 불가)
+func removeUser(state *userState, path string) error {
+	switch strings.ToLower(path) {
+	case "externalid":
+		state.ExternalID = nil
+		return nil
+
+	case "":
+		return ErrInvalidValue
+
+	default:
+		return ErrInvalidPath
+	}
+}
+
+// active 는 bool 또는 "True"/"False" 문자열 (일부 IdP 가 문자열로 보냄)
+func parseActive(value json.RawMessage) (bool, error) {
+	var active bool
+	if err := json.Unmarshal(value, &active); err == nil {
+		return active, nil
+	}
+
+	str, err := parseString(value)
+	if err != nil {
+		return false, ErrInvalidValue
+	}
+
+	active, err = strconv.ParseBool(strings.ToLower(str))
+	if err != nil {
+		return false, ErrInvalidValue
+	}
+	return active, nil
+}
+
+func parseString(value json.RawMessage) (string, error) {
+	var str string
+	if err := json.Unmarshal(value, &str); err != nil {
+		return "", ErrInvalidValue
+	}
+	return str, nil
+}
+
+// Group PATCH 의 members 변경 (권한 부여/회수 대상 회원 ID)
+type groupChange struct {
+	Add    []int64
+	Remove []int64
+}
+
+// Group PATCH 해석
+// - add members / remove members[value eq "id"] / remove members (value 목록)
+func parseGroupPatch(req *PatchRequest) (*groupChange, error) {
+	if len(req.Operations) == 0 {
+		return nil, ErrInvalidSyntax
+	}
+
+	change := &groupChange{}
+	for _, op := range req.Operations {
+		path := strings.TrimSpace(op.Path)
+
+		switch strings.ToLower(op.Op) {
+		case "add":
+			ids, err := parseMemberValues(path, op.Value)
+			if err != nil {
+				return nil, err
+			}
+			change.Add = append(change.Add, ids...)
+
+		case "remove":
+			// members[value eq "id"]
+			if attr, filter, ok := strings.Cut(path, "["); ok && strings.EqualFold(attr, "members") {
+				_, value, err := parseEq(strings.TrimSuffix(filter, "]"))
+				if err != nil {
+					return nil, ErrInvalidPath
+				}
+				id, err := parseMemberID(value)
+				if err != nil {
+					return nil, err
+				}
+				change.Remove = append(change.Remove, id)
+				continue
+			}
+
+			ids, err := parseMemberValues(path, op.Value)
+			if err != nil {
+				return nil, err
+			}
+			change.Remove = append(change.Remove, ids...)
+
+		default:
+			// replace 는 전체 구성원 교체가 필요하므로 지원하지 않음
+			return nil, ErrInvalidSyntax
+		}
+	}
+
+	return change, nil
+}
+
+// path=members + value=[{"value":"id"}] 또는 path 없음 + value={"members":[...]}
+func parseMemberValues(path string, value json.RawMessage) ([]int64, error) {
+	if path == "" {
+		var attrs struct {
+			Members json.RawMessage `json:"members"`
+		}
+		if err := json.Unmarshal(value, &attrs); err != nil || attrs.Members == nil {
+			return nil, ErrInvalidValue
+		}
+		return parseMemberValues("members", attrs.Members)
+	}
+
+	if !strings.EqualFold(path, "members") {
+		return nil, ErrInvalidPath
+	}
+
+	var members []MemberRef
+	if err := json.Unmarshal(value, &members); err != nil {
+		return nil, ErrInvalidValue
+	}
+
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m.Value, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidValue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// 따옴표로 감싼 회원 ID
+func parseMemberID(value string) (int64, error) {
+	str, err := unquote(value)
+	if err != nil {
+		return 0, ErrInvalidPath
+	}
+
+	id, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidValue
+	}
+	return id, nil
+}
@@ -0,0 +1,128 @@
+package scim
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// 인증된 테넌트 ID 저장 키 (ScimAuth 미들웨어에서 설정)
+const TenantKey = "scimTenant"
+
+// Handler
+// - RFC 7644 형식 응답을 사용하므로 공통 응답 포맷을 사용하지 않음
+type ScimHandler struct {
+	service *ScimService
+}
+
+func NewScimHandler(service *ScimService) *ScimHandler {
+	return &ScimHandler{service: service}
+}
+
+// User 생성
+func (h *ScimHandler) CreateUser(c *fiber.Ctx) error {
+	var req UserResource
+	if err := c.BodyParser(&req); err != nil {
+		return Error(c, ErrInvalidSyntax)
+	}
+
+	resp, err := h.service.CreateUser(c.UserContext(), tenantID(c), &req)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	c.Location(resp.Meta.Location)
+	return write(c, fiber.StatusCreated, resp)
+}
+
+// User 조회
+func (h *ScimHandler) GetUser(c *fiber.Ctx) error {
+	resp, err := h.service.GetUser(c.UserContext(), tenantID(c), c.Params("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return write(c, fiber.StatusOK, resp)
+}
+
+// User 목록 (?filter=&startIndex=&count=)
+func (h *ScimHandler) ListUsers(c *fiber.Ctx) error {
+	var req ListQuery
+	if err := c.QueryParser(&req); err != nil {
+		return Error(c, ErrInvalidValue)
+	}
+
+	resp, err := h.service.ListUsers(c.UserContext(), tenantID(c), &req)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return write(c, fiber.StatusOK, resp)
+}
+
+// User 부분 수정
+func (h *ScimHandler) PatchUser(c *fiber.Ctx) error {
+	var req PatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return Error(c, ErrInvalidSyntax)
+	}
+
+	resp, err := h.service.PatchUser(c.UserContext(), tenantID(c), c.Params("id"), &req)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return write(c, fiber.StatusOK, resp)
+}
+
+// User 삭제
+func (h *ScimHandler) DeleteUser(c *fiber.Ctx) error {
+	if err := h.service.DeleteUser(c.UserContext(), tenantID(c), c.Params("id")); err != nil {
+		return Error(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Group 목록
+func (h *ScimHandler) ListGroups(c *fiber.Ctx) error {
+	resp, err := h.service.ListGroups(c.UserContext(), tenantID(c))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return write(c, fiber.StatusOK, resp)
+}
+
+// Group 조회
+func (h *ScimHandler) GetGroup(c *fiber.Ctx) error {
+	resp, err := h.service.GetGroup(c.UserContext(), tenantID(c), c.Params("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return write(c, fiber.StatusOK, resp)
+}
+
+// Group 구성원 변경
+func (h *ScimHandler) PatchGroup(c *fiber.Ctx) error {
+	var req PatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return Error(c, ErrInvalidSyntax)
+	}
+
+	resp, err := h.service.PatchGroup(c.UserContext(), tenantID(c), c.Params("id"), &req)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return write(c, fiber.StatusOK, resp)
+}
+
+// SCIM JSON 응답
+func write(c *fiber.Ctx, status int, body any) error {
+	return c.Status(status).JSON(body, ContentType)
+}
+
+func tenantID(c *fiber.Ctx) string {
+	tenant, _ := c.Locals(TenantKey).(string)
+	return tenant
+}
@@ -0,0 +1,30 @@
+package scim
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type ScimRouter struct {
+	handler *ScimHandler
+}
+
+func NewScimRouter(handler *ScimHandler) *ScimRouter {
+	return &ScimRouter{handler: handler}
+}
+
+// 테넌트 Bearer 토큰 인증 이후
+func (r *ScimRouter) RegisterRoutes(
+	scim fiber.Router,
+) {
+	users := scim.Group("/Users")
+	users.Get("", r.handler.ListUsers)
+	users.Post("", r.handler.CreateUser)
+	users.Get("/:id", r.handler.GetUser)
+	users.Patch("/:id", r.handler.PatchUser)
+	users.Delete("/:id", r.handler.DeleteUser)
+
+	groups := scim.Group("/Groups")
+	groups.Get("", r.handler.ListGroups)
+	groups.Get("/:id", r.handler.GetGroup)
+	groups.Patch("/:id", r.handler.PatchGroup)
+}
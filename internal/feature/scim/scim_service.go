@@ -0,0 +1,745 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"study/internal/config"
+	"study/internal/feature/lifecycle"
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/mapper"
+	"study/internal/shared/model"
+	"study/pkg/log"
+	"study/pkg/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	basePath = "/scim/v2"
+
+	// 목록 조회 기본/최대 개수
+	defaultCount = 100
+	maxCount     = 500
+
+	// 비밀번호 미지정 시 생성하는 임의 비밀번호 길이 (SSO 전용 계정)
+	randomPasswordSize = 32
+
+	// PostgreSQL unique_violation
+	uniqueViolation = "23505"
+)
+
+// ScimService
+// - IdP 프로비저닝 전용 (User=members, Group=member_roles 의 권한별, active=status)
+// - 모든 조회/변경은 요청 테넌트가 생성한 회원으로 제한
+// - ADMIN Group 변경은 allowAdmin 테넌트만, USER Group 은 추가만 (제거 불가)
+type ScimService struct {
+	pool      *pgxpool.Pool
+	queries   *query.Queries
+	lifecycle *lifecycle.LifecycleService
+	cfg       *config.Scim
+}
+
+// 생성자
+func NewScimService(pool *pgxpool.Pool, queries *query.Queries, lifecycleService *lifecycle.LifecycleService, cfg *config.Scim) *ScimService {
+	return &ScimService{pool: pool, queries: queries, lifecycle: lifecycleService, cfg: cfg}
+}
+
+// User 생성
+func (s *ScimService) CreateUser(ctx context.Context, tenantID string, req *UserResource) (resp *UserResource, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimCreateUser")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	userName := strings.TrimSpace(req.UserName)
+	if userName == "" {
+		observability.RecordBusinessError(span, ErrInvalidValue)
+		return nil, ErrInvalidValue
+	}
+
+	// 비밀번호 미지정 시 임의 비밀번호 (IdP 로그인 전용)
+	password := req.Password
+	if password == "" {
+		if password, err = util.RandomString(randomPasswordSize); err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+	}
+
+	status := model.StatusActive
+	if req.Active != nil && !*req.Active {
+		status = model.StatusDisabled
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	// userName(이메일) 중복 체크
+	if _, err = transaction.FindMemberByEmail(ctx, userName); err == nil {
+		observability.RecordBusinessError(span, ErrUniqueness)
+		return nil, ErrUniqueness
+	}
+
+	// 비밀번호 암호화
+	hashed, err := util.HashString(password)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 회원생성
+	memberID, err := transaction.CreateMember(ctx, query.CreateMemberParams{
+		Email:    userName,
+		Password: hashed,
+		Name:     displayName(req),
+		Status:   status,
+	})
+	if err != nil {
+		return nil, s.recordWriteError(span, err)
+	}
+
+	// 기본권한 추가
+	err = transaction.InsertMemberRole(ctx, query.InsertMemberRoleParams{
+		MemberID: memberID,
+		Role:     model.RoleUser,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 비밀번호 이력 저장
+	err = transaction.InsertPasswordHistory(ctx, query.InsertPasswordHistoryParams{
+		MemberID: memberID,
+		Password: hashed,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 테넌트 소유 등록
+	err = transaction.CreateScimMember(ctx, query.CreateScimMemberParams{
+		MemberID:   memberID,
+		TenantID:   tenantID,
+		ExternalID: optionalText(req.ExternalID),
+	})
+	if err != nil {
+		return nil, s.recordWriteError(span, err)
+	}
+
+	resp, err = s.findUser(ctx, transaction, tenantID, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("scim.tenant", tenantID),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "SCIM 회원 생성", log.MapStr("tenant", tenantID), log.MapInt64("memberId", memberID))
+	return resp, nil
+}
+
+// User 조회
+func (s *ScimService) GetUser(ctx context.Context, tenantID string, id string) (resp *UserResource, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimGetUser")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	memberID, err := parseID(id)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	resp, err = s.findUser(ctx, s.queries, tenantID, memberID)
+	if err != nil {
+		s.recordFindError(span, err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// User 목록 (filter + 1부터 시작하는 startIndex 페이징)
+func (s *ScimService) ListUsers(ctx context.Context, tenantID string, req *ListQuery) (resp *ListResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimListUsers")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	filter, err := parseUserFilter(req.Filter)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	startIndex := max(req.StartIndex, 1)
+	count := req.Count
+	if count <= 0 {
+		count = defaultCount
+	}
+	count = min(count, maxCount)
+
+	userName, externalID, active := optionalFilterText(filter.UserName), optionalFilterText(filter.ExternalID), pgtype.Bool{}
+	if filter.Active != nil {
+		active = pgtype.Bool{Bool: *filter.Active, Valid: true}
+	}
+
+	total, err := s.queries.CountScimMembers(ctx, query.CountScimMembersParams{
+		TenantID:   tenantID,
+		UserName:   userName,
+		ExternalID: externalID,
+		Active:     active,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	rows, err := s.queries.ListScimMembers(ctx, query.ListScimMembersParams{
+		TenantID:    tenantID,
+		UserName:    userName,
+		ExternalID:  externalID,
+		Active:      active,
+		LimitCount:  int32(count),
+		OffsetCount: int32(startIndex - 1),
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	resources := make([]any, 0, len(rows))
+	for _, row := range rows {
+		user, err := s.toUser(ctx, s.queries, query.FindScimMemberRow(row))
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		resources = append(resources, user)
+	}
+
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+// User 부분 수정 (비활성화 시 세션/토큰 폐기)
+func (s *ScimService) PatchUser(ctx context.Context, tenantID string, id string, req *PatchRequest) (resp *UserResource, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimPatchUser")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	memberID, err := parseID(id)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	row, err := transaction.FindScimMember(ctx, query.FindScimMemberParams{
+		TenantID: tenantID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		err = notFound(err, ErrUserNotFound)
+		s.recordFindError(span, err)
+		return nil, err
+	}
+
+	state := &userState{
+		UserName:   row.Email,
+		Name:       row.Name,
+		Active:     row.Status == model.StatusActive,
+		ExternalID: mapper.TextPtr(row.ExternalID),
+	}
+	if err = applyUserPatch(state, req); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// userName(이메일) 변경 시 중복 체크
+	if !strings.EqualFold(state.UserName, row.Email) {
+		if found, err := transaction.FindMemberByEmail(ctx, state.UserName); err == nil && found.MemberID != memberID {
+			observability.RecordBusinessError(span, ErrUniqueness)
+			return nil, ErrUniqueness
+		}
+	}
+
+	status := row.Status
+	if state.Active {
+		status = model.StatusActive
+	} else if status == model.StatusActive {
+		status = model.StatusDisabled
+	}
+
+	err = transaction.UpdateScimMember(ctx, query.UpdateScimMemberParams{
+		MemberID: memberID,
+		Email:    state.UserName,
+		Name:     state.Name,
+	})
+	if err != nil {
+		return nil, s.recordWriteError(span, err)
+	}
+
+	err = transaction.UpdateScimExternalID(ctx, query.UpdateScimExternalIDParams{
+		MemberID:   memberID,
+		ExternalID: optionalFilterText(state.ExternalID),
+	})
+	if err != nil {
+		return nil, s.recordWriteError(span, err)
+	}
+
+	// 상태 변경 (비활성화 시 세션 폐기는 lifecycle 훅에서 처리)
+	_, err = s.lifecycle.Transition(ctx, transaction, &lifecycle.Change{
+		MemberID: memberID,
+		To:       status,
+		Reason:   lifecycle.ReasonScimProvisioning,
+		Actor:    lifecycle.ScimActor(tenantID),
+	})
+	if err != nil {
+		return nil, s.recordTransitionError(span, err)
+	}
+
+	resp, err = s.findUser(ctx, transaction, tenantID, memberID)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("scim.tenant", tenantID),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "SCIM 회원 수정", log.MapStr("tenant", tenantID), log.MapInt64("memberId", memberID))
+	return resp, nil
+}
+
+// User 삭제 (DELETED 전이, 세션/토큰 폐기는 lifecycle 훅)
+func (s *ScimService) DeleteUser(ctx context.Context, tenantID string, id string) (err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimDeleteUser")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	memberID, err := parseID(id)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return err
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	_, err = transaction.FindScimMember(ctx, query.FindScimMemberParams{
+		TenantID: tenantID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		err = notFound(err, ErrUserNotFound)
+		s.recordFindError(span, err)
+		return err
+	}
+
+	_, err = s.lifecycle.Transition(ctx, transaction, &lifecycle.Change{
+		MemberID: memberID,
+		To:       model.StatusDeleted,
+		Reason:   lifecycle.ReasonScimDeprovisioned,
+		Actor:    lifecycle.ScimActor(tenantID),
+	})
+	if err != nil {
+		return s.recordTransitionError(span, err)
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.String("scim.tenant", tenantID),
+		attribute.Int64("member.id", memberID),
+	)
+
+	log.InfoCtx(ctx, "SCIM 회원 삭제", log.MapStr("tenant", tenantID), log.MapInt64("memberId", memberID))
+	return nil
+}
+
+// Group 목록 (전체 권한)
+func (s *ScimService) ListGroups(ctx context.Context, tenantID string) (resp *ListResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimListGroups")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	resources := make([]any, 0, len(model.Roles))
+	for _, role := range model.Roles {
+		group, err := s.findGroup(ctx, s.queries, tenantID, role)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		resources = append(resources, group)
+	}
+
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: int64(len(resources)),
+		StartIndex:   1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+// Group 조회
+func (s *ScimService) GetGroup(ctx context.Context, tenantID string, id string) (resp *GroupResource, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimGetGroup")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	role, err := parseRole(id)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	resp, err = s.findGroup(ctx, s.queries, tenantID, role)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Group 구성원 변경 (권한 부여/회수)
+func (s *ScimService) PatchGroup(ctx context.Context, tenantID string, id string, req *PatchRequest) (resp *GroupResource, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ScimPatchGroup")
+	defer observability.EndSpanWithLatency(span, start, 200)
+
+	role, err := parseRole(id)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	change, err := parseGroupPatch(req)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	if err = checkGroupChange(s.tenant(tenantID), role, change); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	// 트랜젝션 시작
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction := s.queries.WithTx(tx)
+
+	// 다른 테넌트 회원은 변경 불가
+	for _, memberID := range slices.Concat(change.Add, change.Remove) {
+		_, err = transaction.FindScimMember(ctx, query.FindScimMemberParams{
+			TenantID: tenantID,
+			MemberID: memberID,
+		})
+		if err != nil {
+			err = notFound(err, ErrInvalidValue)
+			s.recordFindError(span, err)
+			return nil, err
+		}
+	}
+
+	for _, memberID := range change.Add {
+		err = transaction.InsertMemberRole(ctx, query.InsertMemberRoleParams{
+			MemberID: memberID,
+			Role:     role,
+		})
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+	}
+
+	for _, memberID := range change.Remove {
+		err = transaction.DeleteMemberRole(ctx, query.DeleteMemberRoleParams{
+			MemberID: memberID,
+			Role:     role,
+		})
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+	}
+
+	resp, err = s.findGroup(ctx, transaction, tenantID, role)
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	// 커밋
+	if err = tx.Commit(ctx); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("scim.tenant", tenantID),
+		attribute.String("scim.group", string(role)),
+	)
+
+	log.InfoCtx(ctx, "SCIM 그룹 변경",
+		log.MapStr("tenant", tenantID),
+		log.MapStr("group", string(role)),
+		log.MapInt("added", len(change.Add)),
+		log.MapInt("removed", len(change.Remove)),
+	)
+	return resp, nil
+}
+
+// 테넌트 소유 회원 조회 후 User 리소스 변환
+func (s *ScimService) findUser(ctx context.Context, q *query.Queries, tenantID string, memberID int64) (*UserResource, error) {
+	row, err := q.FindScimMember(ctx, query.FindScimMemberParams{
+		TenantID: tenantID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		return nil, notFound(err, ErrUserNotFound)
+	}
+
+	return s.toUser(ctx, q, row)
+}
+
+// 회원 → User 리소스 (소속 그룹 포함)
+func (s *ScimService) toUser(ctx context.Context, q *query.Queries, row query.FindScimMemberRow) (*UserResource, error) {
+	roles, err := q.GetRolesByMemberID(ctx, row.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]GroupRef, 0, len(roles))
+	for _, role := range roles {
+		groups = append(groups, GroupRef{Value: string(role), Display: string(role)})
+	}
+
+	id := strconv.FormatInt(row.MemberID, 10)
+	active := row.Status == model.StatusActive
+	created := mapper.TimeValue(row.CreatedAt)
+	modified := created
+	if row.UpdatedAt.Valid {
+		modified = row.UpdatedAt.Time
+	}
+
+	return &UserResource{
+		Schemas:     []string{SchemaUser},
+		ID:          id,
+		ExternalID:  mapper.TextValue(row.ExternalID),
+		UserName:    row.Email,
+		Name:        &Name{Formatted: row.Name},
+		DisplayName: row.Name,
+		Emails:      []Email{{Value: row.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Groups:      groups,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      created.Format(time.RFC3339),
+			LastModified: modified.Format(time.RFC3339),
+			Location:     basePath + "/Users/" + id,
+		},
+	}, nil
+}
+
+// 권한 → Group 리소스 (테넌트 소유 회원만 구성원으로 표시)
+func (s *ScimService) findGroup(ctx context.Context, q *query.Queries, tenantID string, role model.Role) (*GroupResource, error) {
+	rows, err := q.ListScimMembersByRole(ctx, query.ListScimMembersByRoleParams{
+		TenantID: tenantID,
+		Role:     role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]MemberRef, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, MemberRef{
+			Value:   strconv.FormatInt(row.MemberID, 10),
+			Display: row.Email,
+		})
+	}
+
+	return &GroupResource{
+		Schemas:     []string{SchemaGroup},
+		ID:          string(role),
+		DisplayName: string(role),
+		Members:     members,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Location:     basePath + "/Groups/" + string(role),
+		},
+	}, nil
+}
+
+// 조회 에러 기록 (없는 리소스는 비즈니스 에러)
+func (s *ScimService) recordFindError(span trace.Span, err error) {
+	if err == ErrUserNotFound || err == ErrInvalidValue {
+		observability.RecordBusinessError(span, err)
+		return
+	}
+	observability.RecordServiceError(span, err)
+}
+
+// 쓰기 에러 기록 (유니크 제약 위반은 uniqueness 로 변환)
+func (s *ScimService) recordWriteError(span trace.Span, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		observability.RecordBusinessError(span, ErrUniqueness)
+		return ErrUniqueness
+	}
+	observability.RecordServiceError(span, err)
+	return err
+}
+
+// 상태 전이 에러 기록 (허용되지 않는 전이는 mutability)
+func (s *ScimService) recordTransitionError(span trace.Span, err error) error {
+	switch err {
+	case lifecycle.ErrInvalidTransition:
+		observability.RecordBusinessError(span, ErrMutability)
+		return ErrMutability
+	case lifecycle.ErrMemberNotFound:
+		observability.RecordBusinessError(span, ErrUserNotFound)
+		return ErrUserNotFound
+	default:
+		observability.RecordServiceError(span, err)
+		return err
+	}
+}
+
+// 조회 결과 없음을 도메인 에러로 변환
+func notFound(err error, target error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return target
+	}
+	return err
+}
+
+// User id (회원 ID)
+func parseID(id string) (int64, error) {
+	memberID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, ErrUserNotFound
+	}
+	return memberID, nil
+}
+
+// Group id (권한 이름)
+func parseRole(id string) (model.Role, error) {
+	role := model.Role(id)
+	if !slices.Contains(model.Roles, role) {
+		return "", ErrGroupNotFound
+	}
+	return role, nil
+}
+
+// 요청 테넌트 설정 (인증 미들웨어가 확인한 테넌트라 항상 존재)
+func (s *ScimService) tenant(tenantID string) config.ScimTenant {
+	for _, tenant := range s.cfg.Tenants {
+		if tenant.ID == tenantID {
+			return tenant
+		}
+	}
+	return config.ScimTenant{ID: tenantID}
+}
+
+// Group 변경 허용 여부
+// - ADMIN: 테넌트 토큰으로 전역 관리자 권한을 부여할 수 있으므로 allowAdmin 테넌트만
+// - USER: 회원이면 항상 보유하는 기본 권한이라 제거 불가 (추가는 이미 보유 중이라 변화 없음)
+func checkGroupChange(tenant config.ScimTenant, role model.Role, change *groupChange) error {
+	switch {
+	case role == model.RoleAdmin && !tenant.AllowAdmin:
+		return ErrGroupForbidden
+	case role == model.RoleUser && len(change.Remove) > 0:
+		return ErrGroupImmutable
+	default:
+		return nil
+	}
+}
+
+// 회원 이름 (displayName → name.formatted → userName 순)
+func displayName(req *UserResource) string {
+	if name := strings.TrimSpace(req.DisplayName); name != "" {
+		return name
+	}
+	if req.Name != nil {
+		if name := strings.TrimSpace(req.Name.Formatted); name != "" {
+			return name
+		}
+		if name := strings.TrimSpace(req.Name.GivenName + " " + req.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return req.UserName
+}
+
+func optionalText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
+
+func optionalFilterText(value *string) pgtype.Text {
+	if value == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *value, Valid: true}
+}
@@ -0,0 +1,53 @@
+package scim
+
+import (
+	"testing"
+
+	"study/internal/config"
+	"study/internal/shared/model"
+)
+
+func TestParseRole(t *testing.T) {
+	for _, want := range model.Roles {
+		role, err := parseRole(string(want))
+		if err != nil || role != want {
+			t.Errorf("%s Group 거부됨: %v %v", want, role, err)
+		}
+	}
+
+	for _, id := range []string{"admin", "UNKNOWN"} {
+		if _, err := parseRole(id); err != ErrGroupNotFound {
+			t.Errorf("%s Group 허용됨: %v", id, err)
+		}
+	}
+}
+
+func TestCheckGroupChange(t *testing.T) {
+	tenant := config.ScimTenant{ID: "default"}
+	admin := config.ScimTenant{ID: "idp", AllowAdmin: true}
+	add := &groupChange{Add: []int64{1}}
+	remove := &groupChange{Remove: []int64{1}}
+
+	cases := []struct {
+		name   string
+		tenant config.ScimTenant
+		role   model.Role
+		change *groupChange
+		want   error
+	}{
+		{"USER 추가", tenant, model.RoleUser, add, nil},
+		{"USER 제거", admin, model.RoleUser, remove, ErrGroupImmutable},
+		{"ADMIN 미허용 추가", tenant, model.RoleAdmin, add, ErrGroupForbidden},
+		{"ADMIN 미허용 제거", tenant, model.RoleAdmin, remove, ErrGroupForbidden},
+		{"ADMIN 허용 추가", admin, model.RoleAdmin, add, nil},
+		{"ADMIN 허용 제거", admin, model.RoleAdmin, remove, nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := checkGroupChange(tc.tenant, tc.role, tc.change); err != tc.want {
+				t.Errorf("결과 불일치: %v, 기대: %v", err, tc.want)
+			}
+		})
+	}
+}
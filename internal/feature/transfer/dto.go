@@ -0,0 +1,42 @@
+package transfer
+
+import "time"
+
+// 가져오기 작업 요청 (multipart: format, dryRun, grantAdmin 다음 file, 핸들러에서 직접 파싱)
+type ImportJobRequest struct {
+	Format     string // csv | jsonl (빈 값이면 파일 확장자)
+	DryRun     bool
+	GrantAdmin bool // roles 컬럼의 ADMIN 부여 허용
+}
+
+// 내보내기 작업 요청
+type ExportJobRequest struct {
+	Format   string   `json:"format"`   // csv | jsonl
+	Columns  []string `json:"columns"`  // 비어 있으면 전체
+	Statuses []string `json:"statuses"` // 비어 있으면 삭제되지 않은 전체 회원
+}
+
+// 작업 응답
+type JobResponse struct {
+	ID            int64         `json:"id"`
+	Kind          string        `json:"kind"`
+	Format        string        `json:"format"`
+	Status        string        `json:"status"`
+	DryRun        bool          `json:"dryRun"`
+	TotalRows     int           `json:"totalRows"`
+	SucceededRows int           `json:"succeededRows"`
+	FailedRows    int           `json:"failedRows"`
+	Report        *ImportReport `json:"report,omitempty"` // 가져오기만
+	Error         *string       `json:"error"`
+	Download      *JobDownload  `json:"download"` // 내보내기 파일 / 가져오기 전체 오류 파일
+	CreatedBy     int64         `json:"createdBy"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	StartedAt     *time.Time    `json:"startedAt"`
+	FinishedAt    *time.Time    `json:"finishedAt"`
+}
+
+// 결과 파일 다운로드 (기간 제한 서명 URL)
+type JobDownload struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
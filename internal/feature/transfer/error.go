@@ -0,0 +1,44 @@
+package transfer
+
+import "errors"
+
+// 서비스 에러
+var (
+	// 지원하지 않는 파일 형식 (csv / jsonl)
+	ErrInvalidFormat = errors.New("INVALID_TRANSFER_FORMAT")
+
+	// 가져오기 헤더 오류 (필수 컬럼 누락, 알 수 없는 / 중복 컬럼)
+	ErrInvalidHeader = errors.New("INVALID_IMPORT_HEADER")
+
+	// 내보내기 컬럼 / 상태 조건 오류
+	ErrInvalidColumn = errors.New("INVALID_EXPORT_COLUMN")
+	ErrInvalidStatus = errors.New("INVALID_STATUS")
+
+	// 가져오기 파일 크기 초과
+	ErrFileTooLarge = errors.New("IMPORT_FILE_TOO_LARGE")
+
+	// 작업 없음
+	ErrJobNotFound = errors.New("TRANSFER_JOB_NOT_FOUND")
+
+	// 작업 제한 시간 초과 (jobTimeoutMin)
+	ErrJobTimeout = errors.New("TRANSFER_JOB_TIMEOUT")
+
+	// 제한 시간 안에 끝나지 않은 작업 (서버 재시작 등으로 중단)
+	ErrJobInterrupted = errors.New("TRANSFER_JOB_INTERRUPTED")
+)
+
+// 행 오류 (가져오기 보고서의 code, 해당 행만 제외)
+var (
+	ErrMalformedRow        = errors.New("MALFORMED_ROW")
+	ErrInvalidEmail        = errors.New("INVALID_EMAIL")
+	ErrDuplicateEmail      = errors.New("DUPLICATE_EMAIL") // 파일 안에서 중복
+	ErrEmailAlreadyExists  = errors.New("EMAIL_ALREADY_EXISTS")
+	ErrInvalidName         = errors.New("INVALID_NAME")
+	ErrInvalidAddress      = errors.New("INVALID_ADDRESS")
+	ErrInvalidRole         = errors.New("INVALID_ROLE")
+	ErrRoleNotAllowed      = errors.New("ROLE_NOT_ALLOWED")  // 가져오기로 부여할 수 없는 권한 (grantAdmin 없이 ADMIN)
+	ErrPasswordRequired    = errors.New("PASSWORD_REQUIRED") // password / passwordHash 중 하나만
+	ErrInvalidPassword     = errors.New("INVALID_PASSWORD")
+	ErrInvalidPasswordHash = errors.New("INVALID_PASSWORD_HASH")
+	ErrInvalidCreatedAt    = errors.New("INVALID_CREATED_AT")
+)
@@ -0,0 +1,119 @@
+package transfer
+
+import (
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/pkg/log"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 내보내기 가능한 상태 (삭제 회원 제외)
+var exportStatuses = []model.Status{model.StatusReady, model.StatusActive, model.StatusDisabled}
+
+// 내보내기 옵션
+type ExportOptions struct {
+	Format   Format
+	Columns  []string       // 비어 있으면 전체 (ParseColumns)
+	Statuses []model.Status // 비어 있으면 삭제되지 않은 전체 회원
+}
+
+// 회원 내보내기 (member_id 순 스트리밍, 내보낸 행 수 반환)
+func (s *TransferService) Export(ctx context.Context, w io.Writer, opts ExportOptions) (count int, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ExportMembers")
+	defer observability.EndSpanWithLatency(span, start, 60000)
+
+	columns, err := ParseColumns(opts.Columns)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return 0, err
+	}
+
+	statuses := make([]string, 0, len(opts.Statuses))
+	for _, status := range opts.Statuses {
+		if !slices.Contains(exportStatuses, status) {
+			observability.RecordBusinessError(span, ErrInvalidStatus)
+			return 0, ErrInvalidStatus
+		}
+		statuses = append(statuses, string(status))
+	}
+
+	writer, err := newRowWriter(w, opts.Format, columns)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return 0, err
+	}
+
+	values := make([]any, len(columns))
+	err = s.queries.ExportMembers(ctx, query.ExportMembersParams{Statuses: statuses}, func(row query.ExportMembersRow) error {
+		for i, column := range columns {
+			values[i] = exportValue(row, column)
+		}
+		count++
+		return writer.WriteRow(values)
+	})
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return count, err
+	}
+
+	span.SetAttributes(
+		attribute.String("transfer.format", string(opts.Format)),
+		attribute.Int("transfer.exported", count),
+	)
+
+	log.InfoCtx(ctx, "회원 내보내기 완료", log.MapStr("format", string(opts.Format)), log.MapInt("exported", count))
+	return count, nil
+}
+
+// 컬럼 값 (string | int64 | []string | nil, 시각은 RFC3339 UTC)
+func exportValue(row query.ExportMembersRow, column string) any {
+	switch column {
+	case ColumnMemberID:
+		return row.MemberID
+	case ColumnEmail:
+		return row.Email
+	case ColumnName:
+		return row.Name
+	case ColumnTel:
+		return textValue(row.Tel)
+	case ColumnTelVerifiedAt:
+		return timeValue(row.TelVerifiedAt)
+	case ColumnAddress:
+		return textValue(row.Address)
+	case ColumnStatus:
+		return string(row.Status)
+	case ColumnRoles:
+		return row.Roles
+	case ColumnCreatedAt:
+		return timeValue(row.CreatedAt)
+	case ColumnUpdatedAt:
+		return timeValue(row.UpdatedAt)
+	default:
+		return nil
+	}
+}
+
+func textValue(t pgtype.Text) any {
+	if !t.Valid {
+		return nil
+	}
+	return t.String
+}
+
+func timeValue(t pgtype.Timestamp) any {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
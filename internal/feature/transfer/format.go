@@ -0,0 +1,383 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// 엑셀 등에서 저장한 UTF-8 파일 앞의 BOM
+const utf8BOM = "\ufeff"
+
+// 파일 형식
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+// 형식 이름 (빈 값이면 파일 확장자로 판단)
+func ParseFormat(raw string, filename string) (Format, error) {
+	if raw == "" {
+		raw = strings.TrimPrefix(filepath.Ext(filename), ".")
+	}
+
+	switch Format(strings.ToLower(raw)) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatJSONL, "ndjson":
+		return FormatJSONL, nil
+	default:
+		return "", ErrInvalidFormat
+	}
+}
+
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+// 컬럼 (CSV 헤더, JSONL 키)
+const (
+	ColumnMemberID      = "memberId"
+	ColumnEmail         = "email"
+	ColumnName          = "name"
+	ColumnTel           = "tel"
+	ColumnTelVerifiedAt = "telVerifiedAt"
+	ColumnAddress       = "address"
+	ColumnStatus        = "status"
+	ColumnRoles         = "roles"
+	ColumnPassword      = "password"
+	ColumnPasswordHash  = "passwordHash"
+	ColumnCreatedAt     = "createdAt"
+	ColumnUpdatedAt     = "updatedAt"
+)
+
+// 가져오기 컬럼 (email, name 필수)
+var importColumns = []string{
+	ColumnEmail, ColumnName, ColumnTel, ColumnAddress, ColumnStatus,
+	ColumnRoles, ColumnPassword, ColumnPasswordHash, ColumnCreatedAt,
+}
+
+// 내보내기 컬럼 (기본 순서, 비밀번호 해시는 내보내지 않음)
+var exportColumns = []string{
+	ColumnMemberID, ColumnEmail, ColumnName, ColumnTel, ColumnTelVerifiedAt,
+	ColumnAddress, ColumnStatus, ColumnRoles, ColumnCreatedAt, ColumnUpdatedAt,
+}
+
+// 가져오기에서 무시하는 내보내기 전용 컬럼 (내보낸 파일에 password 컬럼만 추가해 다시 가져오기 가능)
+var ignoredImportColumns = []string{ColumnMemberID, ColumnTelVerifiedAt, ColumnUpdatedAt}
+
+// 내보내기 컬럼 선택 (빈 값이면 전체, 요청 순서 유지)
+func ParseColumns(columns []string) ([]string, error) {
+	if len(columns) == 0 {
+		return slices.Clone(exportColumns), nil
+	}
+
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		column = strings.TrimSpace(column)
+		if !slices.Contains(exportColumns, column) || slices.Contains(selected, column) {
+			return nil, ErrInvalidColumn
+		}
+		selected = append(selected, column)
+	}
+
+	return selected, nil
+}
+
+// 가져오기 한 행 (CSV / JSONL 공통)
+type importRow struct {
+	Email        string   `json:"email"`
+	Name         string   `json:"name"`
+	Tel          string   `json:"tel"`
+	Address      string   `json:"address"`
+	Status       string   `json:"status"`
+	Roles        roleList `json:"roles"`
+	Password     string   `json:"password"`
+	PasswordHash string   `json:"passwordHash"`
+	CreatedAt    string   `json:"createdAt"`
+
+	// 내보내기 전용 (무시)
+	MemberID      any `json:"memberId"`
+	TelVerifiedAt any `json:"telVerifiedAt"`
+	UpdatedAt     any `json:"updatedAt"`
+}
+
+// 권한 목록 (JSON 배열 또는 "USER|ADMIN" 문자열)
+type roleList []string
+
+func (r *roleList) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*r = list
+		return nil
+	}
+
+	var joined string
+	if err := json.Unmarshal(data, &joined); err != nil {
+		return err
+	}
+	*r = splitRoles(joined)
+	return nil
+}
+
+// "USER|ADMIN", "USER,ADMIN" → [USER ADMIN]
+func splitRoles(raw string) []string {
+	return strings.FieldsFunc(raw, func(r rune) bool {
+		return r == '|' || r == ',' || r == ' '
+	})
+}
+
+// 가져오기 파일 읽기
+// - Next 는 (파일 줄 번호, 행) 반환, 끝이면 io.EOF
+// - ErrMalformedRow 는 해당 행만 오류, 그 외 에러는 중단
+type rowReader interface {
+	Next() (line int, row *importRow, err error)
+}
+
+func newRowReader(src io.Reader, format Format) (rowReader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVRowReader(src)
+	case FormatJSONL:
+		return newJSONLRowReader(src), nil
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+// CSV (첫 줄 헤더, 엑셀 UTF-8 BOM 허용)
+type csvRowReader struct {
+	r     *csv.Reader
+	index map[string]int
+}
+
+func newCSVRowReader(src io.Reader) (*csvRowReader, error) {
+	r := csv.NewReader(src)
+	r.ReuseRecord = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, ErrInvalidHeader
+	}
+
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, utf8BOM)
+		}
+		column = strings.TrimSpace(column)
+
+		if _, dup := index[column]; dup {
+			return nil, ErrInvalidHeader
+		}
+		if !slices.Contains(importColumns, column) && !slices.Contains(ignoredImportColumns, column) {
+			return nil, ErrInvalidHeader
+		}
+		index[column] = i
+	}
+
+	if _, ok := index[ColumnEmail]; !ok {
+		return nil, ErrInvalidHeader
+	}
+	if _, ok := index[ColumnName]; !ok {
+		return nil, ErrInvalidHeader
+	}
+
+	return &csvRowReader{r: r, index: index}, nil
+}
+
+func (c *csvRowReader) Next() (int, *importRow, error) {
+	record, err := c.r.Read()
+	if err == io.EOF {
+		return 0, nil, io.EOF
+	}
+
+	// 따옴표 / 컬럼 수 오류는 해당 행만 제외
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.StartLine, nil, ErrMalformedRow
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	line, _ := c.r.FieldPos(0)
+	return line, &importRow{
+		Email:        c.field(record, ColumnEmail),
+		Name:         c.field(record, ColumnName),
+		Tel:          c.field(record, ColumnTel),
+		Address:      c.field(record, ColumnAddress),
+		Status:       c.field(record, ColumnStatus),
+		Roles:        splitRoles(c.field(record, ColumnRoles)),
+		Password:     c.field(record, ColumnPassword),
+		PasswordHash: c.field(record, ColumnPasswordHash),
+		CreatedAt:    c.field(record, ColumnCreatedAt),
+	}, nil
+}
+
+// 컬럼 값 (내보내기에서 수식 방지로 붙인 ' 제거, 비밀번호는 내보내지 않으므로 원문 그대로)
+func (c *csvRowReader) field(record []string, column string) string {
+	i, ok := c.index[column]
+	if !ok {
+		return ""
+	}
+	if column == ColumnPassword || column == ColumnPasswordHash {
+		return record[i]
+	}
+	return unescapeCSVCell(record[i])
+}
+
+// JSONL 한 줄 최대 크기
+const maxJSONLLine = 1024 * 1024
+
+// JSONL (한 줄에 객체 하나, 빈 줄 무시)
+type jsonlRowReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func newJSONLRowReader(src io.Reader) *jsonlRowReader {
+	s := bufio.NewScanner(src)
+	s.Buffer(make([]byte, 64*1024), maxJSONLLine)
+	return &jsonlRowReader{s: s}
+}
+
+func (j *jsonlRowReader) Next() (int, *importRow, error) {
+	for j.s.Scan() {
+		j.line++
+
+		data := bytes.TrimSpace(j.s.Bytes())
+		if j.line == 1 {
+			data = bytes.TrimPrefix(data, []byte(utf8BOM))
+		}
+		if len(data) == 0 {
+			continue
+		}
+
+		var row importRow
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&row); err != nil || dec.More() {
+			return j.line, nil, ErrMalformedRow
+		}
+		return j.line, &row, nil
+	}
+
+	if err := j.s.Err(); err != nil {
+		return 0, nil, err
+	}
+	return 0, nil, io.EOF
+}
+
+// 내보내기 파일 쓰기 (values 는 columns 순서, string | int64 | []string | nil)
+type rowWriter interface {
+	WriteRow(values []any) error
+	Flush() error
+}
+
+func newRowWriter(w io.Writer, format Format, columns []string) (rowWriter, error) {
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, err
+		}
+		return &csvRowWriter{w: cw, record: make([]string, len(columns))}, nil
+	case FormatJSONL:
+		return &jsonlRowWriter{w: bufio.NewWriter(w), columns: columns}, nil
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+// 스프레드시트에서 수식으로 해석되는 첫 글자 (CSV injection)
+const csvFormulaChars = "=+-@\t\r"
+
+// 수식 문자로 시작하는 값은 앞에 ' 를 붙여 문자열로 표시
+func escapeCSVCell(value string) string {
+	if value != "" && strings.IndexByte(csvFormulaChars, value[0]) >= 0 {
+		return "'" + value
+	}
+	return value
+}
+
+// escapeCSVCell 되돌림 (내보낸 파일 다시 가져오기)
+func unescapeCSVCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.IndexByte(csvFormulaChars, value[1]) >= 0 {
+		return value[1:]
+	}
+	return value
+}
+
+// CSV (null 은 빈 값, 권한은 "|" 로 연결, 수식 문자로 시작하는 값은 escapeCSVCell)
+type csvRowWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+func (c *csvRowWriter) WriteRow(values []any) error {
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			c.record[i] = escapeCSVCell(v)
+		case int64:
+			c.record[i] = strconv.FormatInt(v, 10)
+		case []string:
+			c.record[i] = strings.Join(v, "|")
+		default:
+			c.record[i] = ""
+		}
+	}
+	return c.w.Write(c.record)
+}
+
+func (c *csvRowWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// JSONL (컬럼 순서대로 키 출력)
+type jsonlRowWriter struct {
+	w       *bufio.Writer
+	columns []string
+	buf     bytes.Buffer
+}
+
+func (j *jsonlRowWriter) WriteRow(values []any) error {
+	j.buf.Reset()
+	j.buf.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			j.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(j.columns[i])
+		j.buf.Write(key)
+		j.buf.WriteByte(':')
+
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		j.buf.Write(data)
+	}
+	j.buf.WriteString("}\n")
+
+	_, err := j.w.Write(j.buf.Bytes())
+	return err
+}
+
+func (j *jsonlRowWriter) Flush() error {
+	return j.w.Flush()
+}
@@ -0,0 +1,160 @@
+package transfer
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCSVRowReader(t *testing.T) {
+	src := utf8BOM + "email, name ,roles,password,memberId\n" +
+		"a@example.com,홍길동,USER|ADMIN,secret,1\n" +
+		"b@example.com,\"깨진\"행\",USER,secret,2\n" +
+		"c@example.com,김철수\n"
+
+	reader, err := newRowReader(strings.NewReader(src), FormatCSV)
+	if err != nil {
+		t.Fatalf("헤더 읽기 실패: %v", err)
+	}
+
+	line, row, err := reader.Next()
+	if err != nil || line != 2 {
+		t.Fatalf("첫 행 읽기 실패: line=%d err=%v", line, err)
+	}
+	want := &importRow{Email: "a@example.com", Name: "홍길동", Roles: roleList{"USER", "ADMIN"}, Password: "secret"}
+	if !reflect.DeepEqual(row, want) {
+		t.Errorf("row = %+v, want %+v", row, want)
+	}
+
+	// 따옴표 / 컬럼 수 오류는 해당 행만
+	for _, wantLine := range []int{3, 4} {
+		if line, _, err := reader.Next(); err != ErrMalformedRow || line != wantLine {
+			t.Errorf("line=%d err=%v, want line=%d ErrMalformedRow", line, err, wantLine)
+		}
+	}
+	if _, _, err := reader.Next(); err != io.EOF {
+		t.Errorf("EOF 기대: %v", err)
+	}
+
+	headers := []string{"", "name\n", "email,nickname\n", "email,name,email\n"}
+	for _, header := range headers {
+		if _, err := newRowReader(strings.NewReader(header), FormatCSV); err != ErrInvalidHeader {
+			t.Errorf("잘못된 헤더 %q 허용됨: %v", header, err)
+		}
+	}
+}
+
+func TestJSONLRowReader(t *testing.T) {
+	src := `{"email":"a@example.com","name":"홍길동","roles":["USER","ADMIN"],"passwordHash":"$2a$10$x"}` + "\n" +
+		"\n" +
+		`{"email":"b@example.com","name":"김철수","roles":"USER|ADMIN","memberId":3,"updatedAt":null}` + "\n" +
+		`{"email":"c@example.com","nickname":"x"}` + "\n" +
+		`{"email":"d@example.com"} {}` + "\n"
+
+	reader, err := newRowReader(strings.NewReader(src), FormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	line, row, err := reader.Next()
+	if err != nil || line != 1 || row.PasswordHash != "$2a$10$x" || !reflect.DeepEqual(row.Roles, roleList{"USER", "ADMIN"}) {
+		t.Fatalf("첫 행 오류: line=%d row=%+v err=%v", line, row, err)
+	}
+
+	// 빈 줄은 건너뛰고 줄 번호 유지, 권한 문자열 / 내보내기 전용 키 허용
+	line, row, err = reader.Next()
+	if err != nil || line != 3 || !reflect.DeepEqual(row.Roles, roleList{"USER", "ADMIN"}) {
+		t.Fatalf("셋째 줄 오류: line=%d row=%+v err=%v", line, row, err)
+	}
+
+	// 알 수 없는 키, 한 줄에 객체 여러 개
+	for _, wantLine := range []int{4, 5} {
+		if line, _, err := reader.Next(); err != ErrMalformedRow || line != wantLine {
+			t.Errorf("line=%d err=%v, want line=%d ErrMalformedRow", line, err, wantLine)
+		}
+	}
+	if _, _, err := reader.Next(); err != io.EOF {
+		t.Errorf("EOF 기대: %v", err)
+	}
+}
+
+func TestRowWriter(t *testing.T) {
+	columns := []string{ColumnMemberID, ColumnEmail, ColumnTel, ColumnRoles}
+	values := []any{int64(7), "a@example.com", nil, []string{"ADMIN", "USER"}}
+
+	var csvOut bytes.Buffer
+	w, err := newRowWriter(&csvOut, FormatCSV, columns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(values); err != nil || w.Flush() != nil {
+		t.Fatalf("CSV 쓰기 실패: %v", err)
+	}
+	if want := "memberId,email,tel,roles\n7,a@example.com,,ADMIN|USER\n"; csvOut.String() != want {
+		t.Errorf("CSV = %q, want %q", csvOut.String(), want)
+	}
+
+	var jsonlOut bytes.Buffer
+	w, err = newRowWriter(&jsonlOut, FormatJSONL, columns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(values); err != nil || w.Flush() != nil {
+		t.Fatalf("JSONL 쓰기 실패: %v", err)
+	}
+	if want := `{"memberId":7,"email":"a@example.com","tel":null,"roles":["ADMIN","USER"]}` + "\n"; jsonlOut.String() != want {
+		t.Errorf("JSONL = %q, want %q", jsonlOut.String(), want)
+	}
+}
+
+func TestCSVFormulaEscape(t *testing.T) {
+	columns := []string{ColumnEmail, ColumnName, ColumnTel, ColumnAddress}
+	values := []any{"a@example.com", "=HYPERLINK(\"http://evil\")", "+821012345678", "@SUM(A1)"}
+
+	var out bytes.Buffer
+	w, err := newRowWriter(&out, FormatCSV, columns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(values); err != nil || w.Flush() != nil {
+		t.Fatalf("CSV 쓰기 실패: %v", err)
+	}
+	want := "email,name,tel,address\na@example.com,\"'=HYPERLINK(\"\"http://evil\"\")\",'+821012345678,'@SUM(A1)\n"
+	if out.String() != want {
+		t.Errorf("CSV = %q, want %q", out.String(), want)
+	}
+
+	// 내보낸 파일을 다시 가져오면 원래 값 (비밀번호는 그대로)
+	reader, err := newRowReader(strings.NewReader(out.String()), FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, row, err := reader.Next()
+	if err != nil || row.Name != values[1] || row.Tel != values[2] || row.Address != values[3] {
+		t.Errorf("가져오기 값 불일치: %+v %v", row, err)
+	}
+
+	reader, err = newRowReader(strings.NewReader("email,name,password\na@example.com,a,'-secret\n"), FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, row, err := reader.Next(); err != nil || row.Password != "'-secret" {
+		t.Errorf("비밀번호 원문 유지 실패: %+v %v", row, err)
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	if columns, err := ParseColumns(nil); err != nil || !reflect.DeepEqual(columns, exportColumns) {
+		t.Errorf("기본 컬럼 오류: %v %v", columns, err)
+	}
+	if columns, err := ParseColumns([]string{"name", " email"}); err != nil || !reflect.DeepEqual(columns, []string{"name", "email"}) {
+		t.Errorf("컬럼 선택 오류: %v %v", columns, err)
+	}
+	for _, columns := range [][]string{{"password"}, {"passwordHash"}, {"email", "email"}} {
+		if _, err := ParseColumns(columns); err != ErrInvalidColumn {
+			t.Errorf("잘못된 컬럼 %v 허용됨: %v", columns, err)
+		}
+	}
+}
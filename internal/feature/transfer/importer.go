@@ -0,0 +1,439 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"study/internal/feature/member"
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/model"
+	"study/pkg/log"
+	"study/pkg/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 이메일 최대 길이 (RFC 5321)
+const maxEmailLength = 254
+
+// 감사 로그 action
+const (
+	auditMemberImport = "MEMBER_IMPORT"
+	auditRoleGrant    = "ROLE_GRANT" // USER 외 권한 부여 (관리자 API 와 같은 action)
+)
+
+// 가져오기 옵션
+type ImportOptions struct {
+	Format  Format
+	DryRun  bool      // 검증만 (DB 중복 확인 포함, 저장 / 비밀번호 해시 안 함)
+	Errors  io.Writer // 전체 행 오류 JSONL (nil 이면 보고서에만)
+	ActorID int64     // 감사 로그 actor (관리자 API 작업은 요청 관리자, CLI 는 0)
+	Source  string    // 감사 로그 detail (예: job:12, cli)
+
+	// roles 컬럼의 ADMIN 부여 허용 (명시적 opt-in, 없으면 ADMIN 행은 ROLE_NOT_ALLOWED)
+	// - 부여한 권한마다 ROLE_GRANT 감사 로그
+	GrantAdmin bool
+}
+
+// 가져오기로 부여할 수 있는 권한 (USER 는 항상 부여)
+func (o ImportOptions) allowedRoles() []model.Role {
+	if o.GrantAdmin {
+		return []model.Role{model.RoleUser, model.RoleAdmin}
+	}
+	return []model.Role{model.RoleUser}
+}
+
+// 가져오기 결과 (dry-run 이면 Imported 는 가져올 수 있는 행 수)
+type ImportReport struct {
+	DryRun          bool       `json:"dryRun"`
+	Total           int        `json:"total"`
+	Imported        int        `json:"imported"`
+	Failed          int        `json:"failed"`
+	Errors          []RowError `json:"errors"`
+	ErrorsTruncated bool       `json:"errorsTruncated"` // maxReportErrors 초과 (전체는 오류 파일)
+}
+
+// 행 오류
+type RowError struct {
+	Line  int    `json:"line"`
+	Email string `json:"email,omitempty"`
+	Field string `json:"field,omitempty"`
+	Code  string `json:"code"`
+}
+
+// 검증을 통과한 행
+type importRecord struct {
+	line         int
+	email        string
+	key          string // 중복 확인용 (소문자)
+	name         string
+	tel          pgtype.Text
+	address      pgtype.Text
+	status       model.Status
+	roles        []model.Role
+	password     string // 평문 (저장 직전 해시)
+	passwordHash string
+	createdAt    pgtype.Timestamp
+}
+
+// 보고서 + 오류 파일 기록
+type importReporter struct {
+	report    *ImportReport
+	maxErrors int
+	enc       *json.Encoder
+	writeErr  error
+}
+
+func (r *importReporter) fail(line int, email string, field string, err error) {
+	rowErr := RowError{Line: line, Email: email, Field: field, Code: err.Error()}
+
+	r.report.Failed++
+	if len(r.report.Errors) < r.maxErrors {
+		r.report.Errors = append(r.report.Errors, rowErr)
+	} else {
+		r.report.ErrorsTruncated = true
+	}
+
+	if r.enc != nil && r.writeErr == nil {
+		r.writeErr = r.enc.Encode(rowErr)
+	}
+}
+
+// 회원 가져오기
+// - 행 단위로 검증해 오류 행은 보고서에 남기고 나머지만 저장
+// - batchSize 단위로 DB 중복 확인 후 COPY (members, member_roles, member_password_histories)
+// - 전체를 한 트랜잭션으로 처리 (DB 오류 시 전부 취소)
+func (s *TransferService) Import(ctx context.Context, src io.Reader, opts ImportOptions) (report *ImportReport, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "ImportMembers")
+	defer observability.EndSpanWithLatency(span, start, 60000)
+
+	reader, err := newRowReader(src, opts.Format)
+	if err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+
+	report = &ImportReport{DryRun: opts.DryRun, Errors: []RowError{}}
+	reporter := &importReporter{report: report, maxErrors: s.cfg.MaxReportErrors}
+	if opts.Errors != nil {
+		reporter.enc = json.NewEncoder(opts.Errors)
+	}
+
+	// dry-run 은 조회만 (트랜잭션 불필요)
+	q := s.queries
+	var tx pgx.Tx
+	if !opts.DryRun {
+		tx, err = s.pool.Begin(ctx)
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		q = s.queries.WithTx(tx)
+	}
+
+	now := time.Now().UTC()
+	allowedRoles := opts.allowedRoles()
+	seen := map[string]struct{}{}
+	batch := make([]*importRecord, 0, s.cfg.BatchSize)
+
+	for {
+		line, row, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, ErrMalformedRow) {
+			report.Total++
+			reporter.fail(line, "", "", err)
+			continue
+		}
+		if err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+
+		report.Total++
+		rec, field, err := validateRow(row, now, allowedRoles)
+		if err != nil {
+			reporter.fail(line, strings.TrimSpace(row.Email), field, err)
+			continue
+		}
+		rec.line = line
+
+		if _, dup := seen[rec.key]; dup {
+			reporter.fail(line, rec.email, ColumnEmail, ErrDuplicateEmail)
+			continue
+		}
+		seen[rec.key] = struct{}{}
+
+		batch = append(batch, rec)
+		if len(batch) == s.cfg.BatchSize {
+			if err := s.importBatch(ctx, q, batch, reporter, opts); err != nil {
+				observability.RecordServiceError(span, err)
+				return nil, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if err := s.importBatch(ctx, q, batch, reporter, opts); err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+	if reporter.writeErr != nil {
+		observability.RecordServiceError(span, reporter.writeErr)
+		return nil, reporter.writeErr
+	}
+
+	if tx != nil {
+		if err = tx.Commit(ctx); err != nil {
+			observability.RecordServiceError(span, err)
+			return nil, err
+		}
+	}
+
+	// DB 중복은 배치 단위로 기록되므로 줄 번호 순으로 정렬
+	slices.SortStableFunc(report.Errors, func(a, b RowError) int { return a.Line - b.Line })
+
+	span.SetAttributes(
+		attribute.Bool("transfer.dry_run", opts.DryRun),
+		attribute.Bool("transfer.grant_admin", opts.GrantAdmin),
+		attribute.Int("transfer.total", report.Total),
+		attribute.Int("transfer.imported", report.Imported),
+		attribute.Int("transfer.failed", report.Failed),
+	)
+
+	log.InfoCtx(ctx, "회원 가져오기 완료",
+		log.MapBool("dryRun", opts.DryRun),
+		log.MapBool("grantAdmin", opts.GrantAdmin),
+		log.MapInt("total", report.Total),
+		log.MapInt("imported", report.Imported),
+		log.MapInt("failed", report.Failed),
+	)
+	return report, nil
+}
+
+// 배치 저장 (이미 가입된 이메일은 행 오류, 저장한 회원마다 감사 로그)
+func (s *TransferService) importBatch(ctx context.Context, q *query.Queries, batch []*importRecord, reporter *importReporter, opts ImportOptions) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(batch))
+	for i, rec := range batch {
+		keys[i] = rec.key
+	}
+	emails, err := q.FindExistingMemberEmails(ctx, keys)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]struct{}, len(emails))
+	for _, email := range emails {
+		existing[email] = struct{}{}
+	}
+
+	records := make([]*importRecord, 0, len(batch))
+	for _, rec := range batch {
+		if _, ok := existing[rec.key]; ok {
+			reporter.fail(rec.line, rec.email, ColumnEmail, ErrEmailAlreadyExists)
+			continue
+		}
+		records = append(records, rec)
+	}
+
+	if opts.DryRun || len(records) == 0 {
+		reporter.report.Imported += len(records)
+		return nil
+	}
+
+	if err := hashPasswords(records); err != nil {
+		return err
+	}
+
+	// COPY 는 RETURNING 이 없어 member_id 를 먼저 받아 권한 / 비밀번호 이력에 사용
+	ids, err := q.ReserveMemberIDs(ctx, int32(len(records)))
+	if err != nil {
+		return err
+	}
+
+	members := make([]query.CopyMembersParams, len(records))
+	histories := make([]query.CopyPasswordHistoriesParams, len(records))
+	audits := make([]query.CopyMemberAuditLogsParams, 0, len(records))
+	var roles []query.CopyMemberRolesParams
+	for i, rec := range records {
+		members[i] = query.CopyMembersParams{
+			MemberID:  ids[i],
+			Email:     rec.email,
+			Password:  rec.passwordHash,
+			Name:      rec.name,
+			Tel:       rec.tel,
+			Address:   rec.address,
+			Status:    rec.status,
+			CreatedAt: rec.createdAt,
+		}
+		histories[i] = query.CopyPasswordHistoriesParams{MemberID: ids[i], Password: rec.passwordHash}
+		audits = append(audits, query.CopyMemberAuditLogsParams{ActorID: opts.ActorID, MemberID: ids[i], Action: auditMemberImport, Detail: importAuditDetail(opts, rec)})
+		for _, role := range rec.roles {
+			roles = append(roles, query.CopyMemberRolesParams{MemberID: ids[i], Role: role})
+			if role != model.RoleUser {
+				audits = append(audits, query.CopyMemberAuditLogsParams{ActorID: opts.ActorID, MemberID: ids[i], Action: auditRoleGrant, Detail: string(role)})
+			}
+		}
+	}
+
+	if _, err := q.CopyMembers(ctx, members); err != nil {
+		return err
+	}
+	if _, err := q.CopyMemberRoles(ctx, roles); err != nil {
+		return err
+	}
+	if _, err := q.CopyPasswordHistories(ctx, histories); err != nil {
+		return err
+	}
+	if _, err := q.CopyMemberAuditLogs(ctx, audits); err != nil {
+		return err
+	}
+
+	reporter.report.Imported += len(records)
+	return nil
+}
+
+// 감사 로그 detail (가져온 경로, 상태, 권한)
+func importAuditDetail(opts ImportOptions, rec *importRecord) string {
+	roles := make([]string, len(rec.roles))
+	for i, role := range rec.roles {
+		roles[i] = string(role)
+	}
+	return fmt.Sprintf("%s status=%s roles=%s", opts.Source, rec.status, strings.Join(roles, "|"))
+}
+
+// 평문 비밀번호 bcrypt 해시 (CPU 수만큼 병렬)
+func hashPasswords(records []*importRecord) error {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	errs := make([]error, len(records))
+
+	for i, rec := range records {
+		if rec.passwordHash != "" {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			rec.passwordHash, errs[i] = util.HashString(rec.password)
+			rec.password = ""
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// 행 검증 / 정규화 (오류면 컬럼 이름과 행 오류 반환)
+// - password(평문) / passwordHash(bcrypt, 기존 시스템 해시 그대로) 중 하나만 허용
+// - 권한은 USER 항상 포함 (allowedRoles 만 허용), 상태는 ACTIVE(기본) / DISABLED
+func validateRow(row *importRow, now time.Time, allowedRoles []model.Role) (*importRecord, string, error) {
+	rec := &importRecord{
+		email:     strings.TrimSpace(row.Email),
+		name:      strings.TrimSpace(row.Name),
+		status:    model.StatusActive,
+		roles:     []model.Role{model.RoleUser},
+		createdAt: pgtype.Timestamp{Time: now, Valid: true},
+	}
+
+	if !validEmail(rec.email) {
+		return nil, ColumnEmail, ErrInvalidEmail
+	}
+	rec.key = strings.ToLower(rec.email)
+
+	if !member.ValidName(rec.name) {
+		return nil, ColumnName, ErrInvalidName
+	}
+
+	if tel := strings.TrimSpace(row.Tel); tel != "" {
+		normalized, err := member.NormalizeTel(tel)
+		if err != nil {
+			return nil, ColumnTel, err
+		}
+		rec.tel = pgtype.Text{String: normalized, Valid: true}
+	}
+
+	if address := strings.TrimSpace(row.Address); address != "" {
+		if !member.ValidAddress(address) {
+			return nil, ColumnAddress, ErrInvalidAddress
+		}
+		rec.address = pgtype.Text{String: address, Valid: true}
+	}
+
+	if status := strings.TrimSpace(row.Status); status != "" {
+		rec.status = model.Status(strings.ToUpper(status))
+		if rec.status != model.StatusActive && rec.status != model.StatusDisabled {
+			return nil, ColumnStatus, ErrInvalidStatus
+		}
+	}
+
+	for _, raw := range row.Roles {
+		role := model.Role(strings.ToUpper(strings.TrimSpace(raw)))
+		if !slices.Contains(model.Roles, role) {
+			return nil, ColumnRoles, ErrInvalidRole
+		}
+		if !slices.Contains(allowedRoles, role) {
+			return nil, ColumnRoles, ErrRoleNotAllowed
+		}
+		if !slices.Contains(rec.roles, role) {
+			rec.roles = append(rec.roles, role)
+		}
+	}
+
+	password, hash := row.Password, strings.TrimSpace(row.PasswordHash)
+	switch {
+	case (password == "") == (hash == ""):
+		return nil, ColumnPassword, ErrPasswordRequired
+	case hash != "":
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, ColumnPasswordHash, ErrInvalidPasswordHash
+		}
+		rec.passwordHash = hash
+	default:
+		// bcrypt 는 72바이트까지만 사용
+		if len(password) > 72 {
+			return nil, ColumnPassword, ErrInvalidPassword
+		}
+		rec.password = password
+	}
+
+	if createdAt := strings.TrimSpace(row.CreatedAt); createdAt != "" {
+		t, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil || t.After(now) {
+			return nil, ColumnCreatedAt, ErrInvalidCreatedAt
+		}
+		rec.createdAt = pgtype.Timestamp{Time: t.UTC(), Valid: true}
+	}
+
+	return rec, "", nil
+}
+
+// 이메일 형식 (이름 없는 주소 형식만, 예: user@example.com)
+func validEmail(email string) bool {
+	if email == "" || len(email) > maxEmailLength {
+		return false
+	}
+
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email && strings.Contains(email[strings.LastIndex(email, "@"):], ".")
+}
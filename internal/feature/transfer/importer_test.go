@@ -0,0 +1,99 @@
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"study/internal/feature/member"
+	"study/internal/shared/model"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestValidateRow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	userRoles := ImportOptions{}.allowedRoles()
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec, _, err := validateRow(&importRow{
+		Email:        " Hong@Example.com ",
+		Name:         " 홍길동 ",
+		Tel:          "010-1234-5678",
+		Status:       "disabled",
+		Roles:        roleList{"user", "USER"},
+		PasswordHash: string(hash),
+		CreatedAt:    "2020-05-01T09:00:00+09:00",
+	}, now, userRoles)
+	if err != nil {
+		t.Fatalf("정상 행 거부: %v", err)
+	}
+	if rec.email != "Hong@Example.com" || rec.key != "hong@example.com" || rec.name != "홍길동" {
+		t.Errorf("이메일 / 이름 정규화 오류: %+v", rec)
+	}
+	if rec.tel.String != "+821012345678" || rec.status != model.StatusDisabled {
+		t.Errorf("전화번호 / 상태 오류: %+v", rec)
+	}
+	if len(rec.roles) != 1 || rec.roles[0] != model.RoleUser {
+		t.Errorf("권한 오류: %v", rec.roles)
+	}
+	if rec.passwordHash != string(hash) || !rec.createdAt.Time.Equal(time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("비밀번호 해시 / 가입일 오류: %+v", rec)
+	}
+
+	// 기본값: ACTIVE, USER, 가입일 now
+	rec, _, err = validateRow(&importRow{Email: "a@example.com", Name: "a", Password: "secret"}, now, userRoles)
+	if err != nil || rec.status != model.StatusActive || len(rec.roles) != 1 || !rec.createdAt.Time.Equal(now) || rec.password != "secret" {
+		t.Errorf("기본값 오류: %+v %v", rec, err)
+	}
+
+	tests := []struct {
+		name  string
+		row   importRow
+		field string
+		want  error
+	}{
+		{"이메일 형식", importRow{Email: "not-an-email", Name: "a", Password: "x"}, ColumnEmail, ErrInvalidEmail},
+		{"이메일 표시 이름", importRow{Email: "A <a@example.com>", Name: "a", Password: "x"}, ColumnEmail, ErrInvalidEmail},
+		{"이름 누락", importRow{Email: "a@example.com", Password: "x"}, ColumnName, ErrInvalidName},
+		{"전화번호", importRow{Email: "a@example.com", Name: "a", Tel: "12", Password: "x"}, ColumnTel, member.ErrInvalidTel},
+		{"상태", importRow{Email: "a@example.com", Name: "a", Status: "DELETED", Password: "x"}, ColumnStatus, ErrInvalidStatus},
+		{"권한", importRow{Email: "a@example.com", Name: "a", Roles: roleList{"ROOT"}, Password: "x"}, ColumnRoles, ErrInvalidRole},
+		{"관리자 권한", importRow{Email: "a@example.com", Name: "a", Roles: roleList{"USER", "admin"}, Password: "x"}, ColumnRoles, ErrRoleNotAllowed},
+		{"비밀번호 누락", importRow{Email: "a@example.com", Name: "a"}, ColumnPassword, ErrPasswordRequired},
+		{"비밀번호 둘 다", importRow{Email: "a@example.com", Name: "a", Password: "x", PasswordHash: string(hash)}, ColumnPassword, ErrPasswordRequired},
+		{"bcrypt 아닌 해시", importRow{Email: "a@example.com", Name: "a", PasswordHash: "5f4dcc3b5aa765d61d8327deb882cf99"}, ColumnPasswordHash, ErrInvalidPasswordHash},
+		{"비밀번호 72바이트 초과", importRow{Email: "a@example.com", Name: "a", Password: string(make([]byte, 73))}, ColumnPassword, ErrInvalidPassword},
+		{"가입일 형식", importRow{Email: "a@example.com", Name: "a", Password: "x", CreatedAt: "2020-05-01"}, ColumnCreatedAt, ErrInvalidCreatedAt},
+		{"미래 가입일", importRow{Email: "a@example.com", Name: "a", Password: "x", CreatedAt: "2030-01-01T00:00:00Z"}, ColumnCreatedAt, ErrInvalidCreatedAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, field, err := validateRow(&tt.row, now, userRoles); err != tt.want || field != tt.field {
+				t.Errorf("field=%q err=%v, want field=%q err=%v", field, err, tt.field, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRowGrantAdmin(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	row := importRow{Email: "a@example.com", Name: "a", Roles: roleList{"admin"}, Password: "x"}
+
+	// opt-in 없이는 거부
+	if _, field, err := validateRow(&row, now, ImportOptions{}.allowedRoles()); err != ErrRoleNotAllowed || field != ColumnRoles {
+		t.Errorf("field=%q err=%v, want field=%q err=%v", field, err, ColumnRoles, ErrRoleNotAllowed)
+	}
+
+	// grantAdmin 이면 USER + ADMIN
+	rec, _, err := validateRow(&row, now, ImportOptions{GrantAdmin: true}.allowedRoles())
+	if err != nil {
+		t.Fatalf("관리자 권한 행 거부: %v", err)
+	}
+	if len(rec.roles) != 2 || rec.roles[0] != model.RoleUser || rec.roles[1] != model.RoleAdmin {
+		t.Errorf("권한 오류: %v", rec.roles)
+	}
+}
@@ -0,0 +1,420 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"study/internal/observability"
+	"study/internal/query"
+	"study/internal/shared/errorx"
+	"study/internal/shared/mapper"
+	"study/pkg/log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 작업 종류
+const (
+	JobImport = "import"
+	JobExport = "export"
+)
+
+// 작업 상태
+const (
+	JobPending   = "PENDING"
+	JobRunning   = "RUNNING"
+	JobSucceeded = "SUCCEEDED"
+	JobFailed    = "FAILED"
+)
+
+// 결과 파일 저장 경로 (transfer/{jobID}/...)
+const jobKeyPrefix = "transfer/"
+
+// 작업 정리 주기 / 한 번에 삭제하는 결과 파일 수
+const (
+	jobCleanupInterval = 10 * time.Minute
+	jobCleanupBatch    = 100
+)
+
+// 가져오기 작업 시작
+// - 업로드 파일은 요청이 끝나면 사라지므로 임시 파일로 복사 후 백그라운드 실행
+func (s *TransferService) StartImportJob(ctx context.Context, actorID int64, src io.Reader, opts ImportOptions) (resp *JobResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "StartImportJob")
+	defer observability.EndSpanWithLatency(span, start, 1000)
+
+	if opts.Format != FormatCSV && opts.Format != FormatJSONL {
+		observability.RecordBusinessError(span, ErrInvalidFormat)
+		return nil, ErrInvalidFormat
+	}
+
+	path, err := copyToTemp(src, s.MaxUploadBytes())
+	if errors.Is(err, ErrFileTooLarge) {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	job, err := s.queries.CreateMemberTransferJob(ctx, query.CreateMemberTransferJobParams{
+		Kind:      JobImport,
+		Format:    string(opts.Format),
+		DryRun:    opts.DryRun,
+		CreatedBy: actorID,
+	})
+	if err != nil {
+		os.Remove(path)
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	opts.ActorID = actorID
+	opts.Source = fmt.Sprintf("job:%d", job.JobID)
+	go s.runJob(job.JobID, func(ctx context.Context) (*jobResult, error) {
+		defer os.Remove(path)
+		return s.runImport(ctx, job.JobID, path, opts)
+	})
+
+	span.SetAttributes(
+		attribute.Int64("transfer.job_id", job.JobID),
+		attribute.Int64("admin.actor_id", actorID),
+	)
+
+	log.InfoCtx(ctx, "회원 가져오기 작업 등록", log.MapInt64("jobId", job.JobID), log.MapInt64("actorId", actorID), log.MapBool("dryRun", opts.DryRun), log.MapBool("grantAdmin", opts.GrantAdmin))
+	return s.jobResponse(ctx, job)
+}
+
+// 내보내기 작업 시작
+func (s *TransferService) StartExportJob(ctx context.Context, actorID int64, opts ExportOptions) (resp *JobResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "StartExportJob")
+	defer observability.EndSpanWithLatency(span, start, 100)
+
+	// 작업 등록 전에 옵션 검증
+	if opts.Format != FormatCSV && opts.Format != FormatJSONL {
+		observability.RecordBusinessError(span, ErrInvalidFormat)
+		return nil, ErrInvalidFormat
+	}
+	if opts.Columns, err = ParseColumns(opts.Columns); err != nil {
+		observability.RecordBusinessError(span, err)
+		return nil, err
+	}
+	for _, status := range opts.Statuses {
+		if !slices.Contains(exportStatuses, status) {
+			observability.RecordBusinessError(span, ErrInvalidStatus)
+			return nil, ErrInvalidStatus
+		}
+	}
+
+	job, err := s.queries.CreateMemberTransferJob(ctx, query.CreateMemberTransferJobParams{
+		Kind:      JobExport,
+		Format:    string(opts.Format),
+		CreatedBy: actorID,
+	})
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	go s.runJob(job.JobID, func(ctx context.Context) (*jobResult, error) {
+		return s.runExport(ctx, job.JobID, opts)
+	})
+
+	span.SetAttributes(
+		attribute.Int64("transfer.job_id", job.JobID),
+		attribute.Int64("admin.actor_id", actorID),
+	)
+
+	log.InfoCtx(ctx, "회원 내보내기 작업 등록", log.MapInt64("jobId", job.JobID), log.MapInt64("actorId", actorID))
+	return s.jobResponse(ctx, job)
+}
+
+// 작업 조회
+func (s *TransferService) GetJob(ctx context.Context, jobID int64) (resp *JobResponse, err error) {
+	ctx, span, start := observability.StartServiceSpan(ctx, "GetTransferJob")
+	defer observability.EndSpanWithLatency(span, start, 50)
+
+	job, err := s.queries.FindMemberTransferJob(ctx, jobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		observability.RecordBusinessError(span, ErrJobNotFound)
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		observability.RecordServiceError(span, err)
+		return nil, err
+	}
+
+	return s.jobResponse(ctx, job)
+}
+
+// 작업 결과
+type jobResult struct {
+	total     int
+	succeeded int
+	failed    int
+	report    *ImportReport
+	resultKey string
+}
+
+// 백그라운드 작업 실행 (요청 컨텍스트와 분리, jobTimeoutMin 제한)
+func (s *TransferService) runJob(jobID int64, run func(ctx context.Context) (*jobResult, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(s.cfg.JobTimeoutMin)*time.Minute)
+	defer cancel()
+
+	finish := query.FinishMemberTransferJobParams{JobID: jobID, Status: JobSucceeded}
+
+	result, err := func() (result *jobResult, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		if err := s.queries.StartMemberTransferJob(ctx, jobID); err != nil {
+			return nil, err
+		}
+		return run(ctx)
+	}()
+
+	if err != nil {
+		log.ErrorCtx(ctx, "회원 일괄 작업 실패", log.MapInt64("jobId", jobID), log.MapErr("error", err))
+
+		code := errorx.ErrInternal.Error()
+		switch {
+		case isBusinessError(err):
+			code = err.Error()
+		case errors.Is(err, context.DeadlineExceeded):
+			code = ErrJobTimeout.Error()
+		}
+		finish.Status = JobFailed
+		finish.Error = pgtype.Text{String: code, Valid: true}
+	} else {
+		finish.TotalRows = int32(result.total)
+		finish.SucceededRows = int32(result.succeeded)
+		finish.FailedRows = int32(result.failed)
+		if result.resultKey != "" {
+			finish.ResultKey = pgtype.Text{String: result.resultKey, Valid: true}
+		}
+		if result.report != nil {
+			if finish.Report, err = json.Marshal(result.report); err != nil {
+				log.WarnCtx(ctx, "가져오기 보고서 직렬화 실패", log.MapInt64("jobId", jobID), log.MapErr("error", err))
+			}
+		}
+	}
+
+	// 제한 시간 초과여도 상태는 기록
+	if err := s.queries.FinishMemberTransferJob(context.Background(), finish); err != nil {
+		log.Error("회원 일괄 작업 상태 저장 실패", log.MapInt64("jobId", jobID), log.MapErr("error", err))
+		return
+	}
+
+	log.Info("회원 일괄 작업 종료", log.MapInt64("jobId", jobID), log.MapStr("status", finish.Status))
+}
+
+// 가져오기 실행 (행 오류가 있으면 전체 오류 JSONL 을 저장소에 업로드)
+func (s *TransferService) runImport(ctx context.Context, jobID int64, path string, opts ImportOptions) (*jobResult, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	errFile, err := os.CreateTemp("", "member-import-errors-*.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(errFile.Name())
+	defer errFile.Close()
+
+	opts.Errors = errFile
+	report, err := s.Import(ctx, src, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &jobResult{
+		total:     report.Total,
+		succeeded: report.Imported,
+		failed:    report.Failed,
+		report:    report,
+	}
+
+	if report.Failed > 0 {
+		result.resultKey = fmt.Sprintf("%s%d/errors.jsonl", jobKeyPrefix, jobID)
+		if err := s.upload(ctx, result.resultKey, errFile.Name(), FormatJSONL); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// 내보내기 실행 (임시 파일에 쓴 뒤 저장소에 업로드)
+func (s *TransferService) runExport(ctx context.Context, jobID int64, opts ExportOptions) (*jobResult, error) {
+	out, err := os.CreateTemp("", "member-export-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(out.Name())
+	defer out.Close()
+
+	count, err := s.Export(ctx, out, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s%d/members.%s", jobKeyPrefix, jobID, opts.Format)
+	if err := s.upload(ctx, key, out.Name(), opts.Format); err != nil {
+		return nil, err
+	}
+
+	return &jobResult{total: count, succeeded: count, resultKey: key}, nil
+}
+
+// 결과 파일 업로드 (파일 전체를 메모리에 올리지 않고 스트리밍)
+func (s *TransferService) upload(ctx context.Context, key string, path string, format Format) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return s.storage.PutStream(ctx, key, f, info.Size(), format.ContentType())
+}
+
+// 작업 정리
+// - 제한 시간이 지나도 PENDING / RUNNING 인 작업은 실패 처리 (서버 재시작으로 중단된 작업, 업로드 임시 파일이 없어 재개 불가)
+// - 보관 기간(resultTTLHours)이 지난 결과 파일 삭제
+// - 실행 중인 작업은 jobTimeoutMin 안에 끝나므로 여러 인스턴스에서 실행해도 안전
+func (s *TransferService) Cleanup(ctx context.Context) error {
+	// 제한 시간 직후 상태 저장과 겹치지 않도록 1분 여유
+	failed, err := s.queries.FailStaleMemberTransferJobs(ctx, query.FailStaleMemberTransferJobsParams{
+		Error:      pgtype.Text{String: ErrJobInterrupted.Error(), Valid: true},
+		TimeoutMin: int32(s.cfg.JobTimeoutMin + 1),
+	})
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		log.WarnCtx(ctx, "중단된 회원 일괄 작업 실패 처리", log.MapInt64("jobs", failed))
+	}
+
+	for {
+		results, err := s.queries.ListExpiredMemberTransferResults(ctx, query.ListExpiredMemberTransferResultsParams{
+			RetentionHours: int32(s.cfg.ResultTTLHours),
+			LimitCount:     jobCleanupBatch,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			if err := s.storage.Delete(ctx, result.ResultKey); err != nil {
+				return err
+			}
+			if err := s.queries.ClearMemberTransferResult(ctx, result.JobID); err != nil {
+				return err
+			}
+		}
+
+		if len(results) < jobCleanupBatch {
+			return nil
+		}
+	}
+}
+
+// 기동 시 한 번, 이후 주기적으로 작업 정리 (ctx 종료 시 중단)
+func (s *TransferService) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(jobCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Cleanup(ctx); err != nil {
+			log.ErrorCtx(ctx, "회원 일괄 작업 정리 실패", log.MapErr("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *TransferService) jobResponse(ctx context.Context, job query.MemberTransferJob) (*JobResponse, error) {
+	resp := &JobResponse{
+		ID:            job.JobID,
+		Kind:          job.Kind,
+		Format:        job.Format,
+		Status:        job.Status,
+		DryRun:        job.DryRun,
+		TotalRows:     int(job.TotalRows),
+		SucceededRows: int(job.SucceededRows),
+		FailedRows:    int(job.FailedRows),
+		Error:         mapper.TextPtr(job.Error),
+		CreatedBy:     job.CreatedBy,
+		CreatedAt:     mapper.TimeValue(job.CreatedAt),
+		StartedAt:     mapper.TimePtr(job.StartedAt),
+		FinishedAt:    mapper.TimePtr(job.FinishedAt),
+	}
+
+	if len(job.Report) > 0 {
+		var report ImportReport
+		if err := json.Unmarshal(job.Report, &report); err != nil {
+			return nil, err
+		}
+		resp.Report = &report
+	}
+
+	if job.ResultKey.Valid {
+		url, err := s.storage.SignedURL(ctx, job.ResultKey.String, s.urlTTL)
+		if err != nil {
+			return nil, err
+		}
+		resp.Download = &JobDownload{URL: url, ExpiresAt: time.Now().Add(s.urlTTL)}
+	}
+
+	return resp, nil
+}
+
+// 업로드 파일 → 임시 파일 (최대 크기 초과 시 ErrFileTooLarge)
+func copyToTemp(src io.Reader, maxBytes int64) (string, error) {
+	f, err := os.CreateTemp("", "member-import-*")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, io.LimitReader(src, maxBytes+1))
+	if err == nil && n > maxBytes {
+		err = ErrFileTooLarge
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+// 작업 실패 사유로 그대로 노출하는 에러
+func isBusinessError(err error) bool {
+	switch err {
+	case ErrInvalidFormat, ErrInvalidHeader, ErrInvalidColumn, ErrInvalidStatus, ErrFileTooLarge, ErrJobNotFound:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,194 @@
+package transfer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	"study/internal/feature/auth"
+	"study/internal/shared/errorx"
+	"study/internal/shared/model"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// multipart 텍스트 필드 최대 길이
+const maxFormValueBytes = 64
+
+var errFormValueTooLong = errors.New("form value too long")
+
+// Handler
+type TransferHandler struct {
+	service *TransferService
+}
+
+func NewTransferHandler(service *TransferService) *TransferHandler {
+	return &TransferHandler{service: service}
+}
+
+// 회원 가져오기 작업 등록 (multipart: format, dryRun, grantAdmin, file)
+// - 본문은 스트리밍 (BodyLimit 미들웨어 제외 경로), 파일 파트를 바로 임시 파일로 복사
+// - format / dryRun / grantAdmin 은 file 보다 앞 파트로 전달 (file 이후 파트는 읽지 않음)
+func (h *TransferHandler) Import(c *fiber.Ctx) error {
+	// chunked 는 크기를 미리 알 수 없어 거부
+	size := c.Request().Header.ContentLength()
+	if size < 0 {
+		return c.Status(fiber.StatusLengthRequired).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "Content-Length 필요", nil))
+	}
+	if size > h.service.MaxRequestBytes() {
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(response.Error(ErrFileTooLarge.Error(), "회원 가져오기 실패", nil))
+	}
+
+	boundary := string(c.Request().Header.MultipartFormBoundary())
+	if boundary == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "multipart 요청 필요", nil))
+	}
+
+	var req ImportJobRequest
+	reader := multipart.NewReader(requestBody(c), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequiredFieldMissing.Error(), "file 필요", nil))
+		}
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "요청 파싱 실패", nil))
+		}
+
+		switch part.FormName() {
+		case "format":
+			req.Format, err = formValue(part)
+		case "dryRun":
+			req.DryRun, err = formBool(part)
+		case "grantAdmin":
+			req.GrantAdmin, err = formBool(part)
+		case "file":
+			return h.startImport(c, part, req)
+		}
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "요청 파싱 실패", nil))
+		}
+	}
+}
+
+// 파일 파트 → 가져오기 작업 등록
+func (h *TransferHandler) startImport(c *fiber.Ctx, file *multipart.Part, req ImportJobRequest) error {
+	ctx := c.UserContext()
+
+	format, err := ParseFormat(req.Format, file.FileName())
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 가져오기 실패", nil))
+	}
+
+	job, err := h.service.StartImportJob(ctx, actorID(c), file, ImportOptions{Format: format, DryRun: req.DryRun, GrantAdmin: req.GrantAdmin})
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 가져오기 실패", nil))
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(response.OK("회원 가져오기 작업 등록", job))
+}
+
+// 요청 본문 (스트리밍이 아니면 읽어 둔 본문)
+func requestBody(c *fiber.Ctx) io.Reader {
+	if stream := c.Request().BodyStream(); stream != nil {
+		return stream
+	}
+	return bytes.NewReader(c.Body())
+}
+
+// multipart 텍스트 필드 (짧은 값만)
+func formValue(part *multipart.Part) (string, error) {
+	value, err := io.ReadAll(io.LimitReader(part, maxFormValueBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if len(value) > maxFormValueBytes {
+		return "", errFormValueTooLong
+	}
+	return string(value), nil
+}
+
+// multipart bool 필드 (true / false / 1 / 0)
+func formBool(part *multipart.Part) (bool, error) {
+	value, err := formValue(part)
+	if err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(value)
+}
+
+// 회원 내보내기 작업 등록
+func (h *TransferHandler) Export(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req ExportJobRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "JSON 파싱 실패", nil))
+	}
+
+	format, err := ParseFormat(req.Format, "")
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 내보내기 실패", nil))
+	}
+
+	statuses := make([]model.Status, len(req.Statuses))
+	for i, status := range req.Statuses {
+		statuses[i] = model.Status(status)
+	}
+
+	job, err := h.service.StartExportJob(ctx, actorID(c), ExportOptions{Format: format, Columns: req.Columns, Statuses: statuses})
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "회원 내보내기 실패", nil))
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(response.OK("회원 내보내기 작업 등록", job))
+}
+
+// 작업 조회 (진행 상태, 가져오기 보고서, 결과 파일 다운로드 URL)
+func (h *TransferHandler) GetJob(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	jobID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrJobNotFound.Error(), "작업 없음", nil))
+	}
+
+	job, err := h.service.GetJob(ctx, jobID)
+	if err != nil {
+		return c.Status(errorStatus(err)).JSON(response.Error(errorCode(err), "작업 조회 실패", nil))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.OK("작업 조회 성공", job))
+}
+
+// 요청한 관리자 회원 ID (RequireRole 이후라 Claims 항상 존재)
+func actorID(c *fiber.Ctx) int64 {
+	return auth.ClaimsFrom(c).MemberID
+}
+
+// 서비스 에러 → HTTP 상태
+func errorStatus(err error) int {
+	switch err {
+	case ErrJobNotFound:
+		return fiber.StatusNotFound
+	case ErrFileTooLarge:
+		return fiber.StatusRequestEntityTooLarge
+	default:
+		if isBusinessError(err) {
+			return fiber.StatusBadRequest
+		}
+		return fiber.StatusInternalServerError
+	}
+}
+
+// 응답 에러 코드 (내부 에러 내용은 노출하지 않음)
+func errorCode(err error) string {
+	if isBusinessError(err) {
+		return err.Error()
+	}
+	return errorx.ErrInternal.Error()
+}
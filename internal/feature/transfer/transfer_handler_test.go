@@ -0,0 +1,84 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"study/internal/config"
+	"study/internal/middleware"
+	"study/internal/shared/errorx"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 작업 등록(DB) 이전 단계의 요청 검증
+func TestImportHandlerRejects(t *testing.T) {
+	service := NewTransferService(nil, nil, nil, &config.MemberTransfer{MaxUploadMB: 1}, &config.Storage{})
+	handler := NewTransferHandler(service)
+
+	app := fiber.New(fiber.Config{BodyLimit: 1024, StreamRequestBody: true, DisablePreParseMultipartForm: true})
+	app.Use(middleware.BodyLimit(1024, fiber.MethodPost+" /import"))
+	app.Post("/import", handler.Import)
+
+	// fields 순서대로 파트 작성 (file 은 name=file)
+	form := func(fields ...[2]string) (*bytes.Buffer, string) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		for _, f := range fields {
+			if f[0] == "file" {
+				part, _ := w.CreateFormFile("file", "members.txt")
+				part.Write([]byte(f[1]))
+				continue
+			}
+			w.WriteField(f[0], f[1])
+		}
+		w.Close()
+		return &body, w.FormDataContentType()
+	}
+
+	large, largeType := form([2]string{"file", strings.Repeat("a", 2*1024*1024)})
+	noFile, noFileType := form([2]string{"format", "csv"})
+	badFormat, badFormatType := form([2]string{"file", "email\n"})
+	badDryRun, badDryRunType := form([2]string{"dryRun", "maybe"}, [2]string{"file", "email\n"})
+
+	cases := []struct {
+		name        string
+		body        *bytes.Buffer
+		contentType string
+		status      int
+		code        string
+	}{
+		{"전체 크기 초과", large, largeType, fiber.StatusRequestEntityTooLarge, ErrFileTooLarge.Error()},
+		{"multipart 아님", bytes.NewBufferString("{}"), fiber.MIMEApplicationJSON, fiber.StatusBadRequest, errorx.ErrRequestParseFailed.Error()},
+		{"file 누락", noFile, noFileType, fiber.StatusBadRequest, errorx.ErrRequiredFieldMissing.Error()},
+		{"형식 판별 불가", badFormat, badFormatType, fiber.StatusBadRequest, ErrInvalidFormat.Error()},
+		{"dryRun 형식", badDryRun, badDryRunType, fiber.StatusBadRequest, errorx.ErrRequestParseFailed.Error()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodPost, "/import", tc.body)
+			req.Header.Set(fiber.HeaderContentType, tc.contentType)
+
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Fatalf("요청 실패: %v", err)
+			}
+			if resp.StatusCode != tc.status {
+				t.Fatalf("상태 코드 불일치: %d, 기대: %d", resp.StatusCode, tc.status)
+			}
+
+			var body struct {
+				Code string `json:"code"`
+			}
+			json.NewDecoder(resp.Body).Decode(&body)
+			if body.Code != tc.code {
+				t.Errorf("에러 코드 불일치: %q, 기대: %q", body.Code, tc.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package transfer
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type TransferRouter struct {
+	handler *TransferHandler
+}
+
+func NewTransferRouter(handler *TransferHandler) *TransferRouter {
+	return &TransferRouter{handler: handler}
+}
+
+// 관리자 권한 확인 이후
+func (r *TransferRouter) RegisterRoutes(
+	admin fiber.Router,
+) {
+	members := admin.Group("/members")
+
+	members.Post("/import", r.handler.Import)
+	members.Post("/export", r.handler.Export)
+	members.Get("/jobs/:id", r.handler.GetJob)
+}
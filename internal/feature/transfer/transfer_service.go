@@ -0,0 +1,43 @@
+package transfer
+
+import (
+	"time"
+
+	"study/internal/config"
+	"study/internal/query"
+	"study/internal/storage"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TransferService
+// - 회원 일괄 가져오기 / 내보내기 (관리자 API 비동기 작업, cmd/membertransfer 공용)
+// - storage 는 비동기 작업 결과 파일 저장에만 사용 (CLI 는 nil)
+type TransferService struct {
+	pool    *pgxpool.Pool
+	queries *query.Queries
+	storage storage.Storage
+	cfg     *config.MemberTransfer
+	urlTTL  time.Duration // 결과 파일 다운로드 URL 유효 시간
+}
+
+// 생성자
+func NewTransferService(pool *pgxpool.Pool, queries *query.Queries, storage storage.Storage, cfg *config.MemberTransfer, storageCfg *config.Storage) *TransferService {
+	return &TransferService{
+		pool:    pool,
+		queries: queries,
+		storage: storage,
+		cfg:     cfg,
+		urlTTL:  time.Duration(storageCfg.URLExpireMin) * time.Minute,
+	}
+}
+
+// 가져오기 파일 최대 크기
+func (s *TransferService) MaxUploadBytes() int64 {
+	return int64(s.cfg.MaxUploadMB) * 1024 * 1024
+}
+
+// 가져오기 요청 본문 최대 크기 (multipart 헤더 여유 포함)
+func (s *TransferService) MaxRequestBytes() int {
+	return s.cfg.BodyLimit()
+}
@@ -0,0 +1,27 @@
+package mail
+
+import (
+	"context"
+
+	"study/pkg/log"
+)
+
+// ConsoleSender
+// - 실제 발송 없이 로그로 출력 (로컬 개발용, 운영 사용 금지)
+type ConsoleSender struct {
+	from string
+}
+
+func NewConsoleSender(from string) *ConsoleSender {
+	return &ConsoleSender{from: from}
+}
+
+func (s *ConsoleSender) Send(ctx context.Context, to string, subject string, text string) error {
+	log.InfoCtx(ctx, "메일 발송 (console)",
+		log.MapStr("from", s.from),
+		log.MapStr("to", to),
+		log.MapStr("subject", subject),
+		log.MapStr("text", text),
+	)
+	return nil
+}
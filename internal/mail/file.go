@@ -0,0 +1,62 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"study/pkg/util"
+)
+
+// FileSender
+// - 발송 내역을 JSONL 파일에 추가 (개발 / 통합 테스트에서 내용 확인용)
+type FileSender struct {
+	mu   sync.Mutex
+	from string
+	path string
+}
+
+// 생성자 (상위 디렉터리가 없으면 생성)
+func NewFileSender(from string, path string) (*FileSender, error) {
+	if !filepath.IsAbs(path) {
+		path = util.GetPath(path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	return &FileSender{from: from, path: path}, nil
+}
+
+// 발송 내역 한 줄
+type fileRecord struct {
+	Message
+	SentAt time.Time `json:"sentAt"`
+}
+
+func (s *FileSender) Send(ctx context.Context, to string, subject string, text string) error {
+	line, err := json.Marshal(fileRecord{
+		Message: Message{From: s.from, To: to, Subject: subject, Text: text},
+		SentAt:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,139 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail", "sent.jsonl")
+	sender, err := NewFileSender("no-reply@example.com", path)
+	if err != nil {
+		t.Fatalf("생성 실패: %v", err)
+	}
+
+	if err := sender.Send(context.Background(), "user@example.com", "가입 승인", "승인되었습니다"); err != nil {
+		t.Fatalf("발송 실패: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("파일 읽기 실패: %v", err)
+	}
+
+	var record fileRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		t.Fatalf("JSONL 파싱 실패: %v", err)
+	}
+	if record.Message != (Message{From: "no-reply@example.com", To: "user@example.com", Subject: "가입 승인", Text: "승인되었습니다"}) {
+		t.Fatalf("발송 내역 오류: %+v", record.Message)
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	text := strings.Repeat("회원가입이 승인되었습니다. ", 10)
+	raw := buildMessage(Message{From: "Study <no-reply@example.com>", To: "user@example.com", Subject: "가입 승인 안내\r\nBcc: evil@example.com", Text: text}, time.Now())
+
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > 998 {
+			t.Fatalf("줄 길이 초과: %d", len(line))
+		}
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("메일 파싱 실패: %v", err)
+	}
+	if msg.Header.Get("Bcc") != "" {
+		t.Fatal("제목 개행으로 헤더가 추가되면 안 됨")
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "가입 승인 안내\r\nBcc: evil@example.com" {
+		t.Fatalf("제목 디코딩 오류: %q, %v", subject, err)
+	}
+
+	body, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, msg.Body))
+	if err != nil || string(body) != text {
+		t.Fatalf("본문 디코딩 오류: %q, %v", body, err)
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen 실패: %v", err)
+	}
+	defer ln.Close()
+
+	// 최소 SMTP 서버 (STARTTLS / AUTH 미지원)
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		tp.PrintfLine("220 localhost ESMTP")
+
+		var commands []string
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				received <- commands
+				return
+			}
+			commands = append(commands, line)
+
+			switch {
+			case strings.HasPrefix(line, "EHLO"):
+				tp.PrintfLine("250 localhost")
+			case line == "DATA":
+				tp.PrintfLine("354 go ahead")
+				tp.ReadDotLines()
+				tp.PrintfLine("250 queued")
+			case line == "QUIT":
+				tp.PrintfLine("221 bye")
+				received <- commands
+				return
+			default:
+				tp.PrintfLine("250 ok")
+			}
+		}
+	}()
+
+	sender := NewSMTPSender("Study <no-reply@example.com>", "127.0.0.1", ln.Addr().(*net.TCPAddr).Port, "", "", time.Second)
+	if err := sender.Send(context.Background(), "user@example.com", "가입 승인", "승인되었습니다"); err != nil {
+		t.Fatalf("발송 실패: %v", err)
+	}
+
+	commands := <-received
+	want := []string{"MAIL FROM:<no-reply@example.com>", "RCPT TO:<user@example.com>", "DATA", "QUIT"}
+	got := make([]string, 0, len(commands))
+	for _, c := range commands {
+		if !strings.HasPrefix(c, "EHLO") {
+			got = append(got, c)
+		}
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("SMTP 명령 오류: %v", got)
+	}
+
+	// 잘못된 수신 주소는 연결 전에 거부
+	if err := sender.Send(context.Background(), "not-an-address", "제목", "본문"); err == nil {
+		t.Fatal("잘못된 주소는 에러여야 함")
+	}
+}
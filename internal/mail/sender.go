@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"study/internal/config"
+)
+
+// 메일 (발신 주소는 설정의 from, 본문은 text/plain)
+type Message struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+}
+
+// Sender
+// - 메일 발송 추상화 (개발: console / file, 운영: smtp)
+type Sender interface {
+	Send(ctx context.Context, to string, subject string, text string) error
+}
+
+// 설정의 provider 에 맞는 발송기 생성
+func New(cfg *config.Mail) (Sender, error) {
+	switch cfg.Provider {
+	case "console":
+		return NewConsoleSender(cfg.From), nil
+	case "file":
+		return NewFileSender(cfg.From, cfg.File.Path)
+	case "smtp":
+		return NewSMTPSender(cfg.From, cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, time.Duration(cfg.SMTP.TimeoutSec)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 mail provider: %q", cfg.Provider)
+	}
+}
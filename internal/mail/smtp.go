@@ -0,0 +1,138 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// 암묵적 TLS 포트 (SMTPS, 그 외는 STARTTLS 지원 시 사용)
+const smtpsPort = 465
+
+// base64 본문 줄 길이 (RFC 2045 76자 제한)
+const bodyLineLength = 76
+
+// SMTPSender
+// - SMTP 서버로 직접 발송 (465 는 TLS 연결, 그 외는 STARTTLS 가능하면 업그레이드)
+// - username 이 있으면 PLAIN 인증 (TLS 연결에서만 허용)
+type SMTPSender struct {
+	from     string
+	host     string
+	port     int
+	username string
+	password string
+	timeout  time.Duration
+}
+
+func NewSMTPSender(from string, host string, port int, username string, password string, timeout time.Duration) *SMTPSender {
+	return &SMTPSender{
+		from:     from,
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		timeout:  timeout,
+	}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, to string, subject string, text string) error {
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return err
+	}
+	rcpt, err := mail.ParseAddress(to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err = client.Rcpt(rcpt.Address); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(buildMessage(Message{From: from.String(), To: rcpt.String(), Subject: subject, Text: text}, time.Now())); err != nil {
+		w.Close()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// 서버 연결 (465 는 TLS)
+func (s *SMTPSender) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(s.host, strconv.Itoa(s.port))
+	if s.port == smtpsPort {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: s.host}}
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+// RFC 5322 메일 본문 (제목은 RFC 2047 인코딩, 본문은 UTF-8 base64)
+func buildMessage(m Message, now time.Time) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + m.From + "\r\n")
+	b.WriteString("To: " + m.To + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", m.Subject) + "\r\n")
+	b.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(m.Text))
+	for len(body) > bodyLineLength {
+		b.WriteString(body[:bodyLineLength] + "\r\n")
+		body = body[bodyLineLength:]
+	}
+	b.WriteString(body + "\r\n")
+
+	return b.Bytes()
+}
@@ -12,5 +12,6 @@ func Init() {
 		dbmetrics.HttpErrorsTotal,
 		dbmetrics.ApiDuration,
 		dbmetrics.QueryMaxDuration,
+		dbmetrics.AccessTokenRenewalsTotal,
 	)
 }
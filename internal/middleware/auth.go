@@ -1,7 +1,12 @@
 package middleware
 
 import (
+	"context"
+	"strconv"
+	"strings"
+
 	"study/internal/feature/auth"
+	"study/pkg/dbmetrics"
 	"study/pkg/response"
 
 	"github.com/gofiber/fiber/v2"
@@ -9,20 +14,103 @@ import (
 
 type AuthMiddlewareConfig struct {
 	CookieName string
+	Cookies    *auth.CookieService
+	DPoP       *auth.DPoPVerifier
+}
+
+func NewAuthMiddlewareConfig(cookies *auth.CookieService, dpop *auth.DPoPVerifier) *AuthMiddlewareConfig {
+	return &AuthMiddlewareConfig{CookieName: cookies.Name, Cookies: cookies, DPoP: dpop}
+}
+
+// 자동 재발급된 access 토큰 응답 헤더 (헤더 토큰 방식)
+const HeaderRenewedAccessToken = "X-Access-Token"
+
+// refresh 토큰으로 access 토큰 재발급 (세션 / 회원 상태 확인 포함)
+type AccessRenewer interface {
+	Refresh(ctx context.Context, refreshToken string, jkt string) (*auth.LoginResponse, error)
+}
+
+// WWW-Authenticate error 코드 (RFC 6750 3.1, RFC 9449 7.1, RFC 9470 3)
+const (
+	authErrorInvalidToken                   = "invalid_token"
+	authErrorInsufficientScope              = "insufficient_scope"
+	authErrorInvalidDPoPProof               = "invalid_dpop_proof"
+	authErrorInsufficientUserAuthentication = "insufficient_user_authentication"
+)
+
+// BFF access 쿠키로 전달된 토큰의 전송자 유형
+// - 브라우저 HttpOnly 쿠키는 DPoP proof 를 만들 수 없으므로 Bearer 허용 여부와 별개로 취급 (CSRF 토큰으로 보호)
+const tokenSenderCookie = "Cookie"
+
+// 라우트 그룹별 인증 옵션
+type authOptions struct {
+	allowPasswordExpired bool
+	allowClientTokens    bool
+	optional             bool
+	renewer              AccessRenewer
+}
+
+type AuthOption func(*authOptions)
+
+// 비밀번호 만료 상태의 제한 토큰 허용 (비밀번호 변경 라우트 전용)
+func AllowPasswordExpired() AuthOption {
+	return func(o *authOptions) {
+		o.allowPasswordExpired = true
+	}
+}
+
+// OAuth 클라이언트에 발급한 회원 토큰 허용 (userinfo 라우트 전용)
+// - 클라이언트 토큰은 1st-party 토큰과 서명 키 / audience 가 같으므로 기본적으로 거부
+func AllowClientTokens() AuthOption {
+	return func(o *authOptions) {
+		o.allowClientTokens = true
+	}
 }
 
-func NewAuthMiddlewareConfig(cookieName string) *AuthMiddlewareConfig {
-	return &AuthMiddlewareConfig{CookieName: cookieName}
+// 익명 / 로그인 사용자 공용 라우트
+// - 토큰이 없으면 Claims 없이 진행, 토큰이 있으면 일반 모드와 같이 검증 (잘못된 토큰은 401)
+func OptionalAuth() AuthOption {
+	return func(o *authOptions) {
+		o.optional = true
+	}
+}
+
+// 만료된 access 토큰을 refresh 쿠키로 재발급 후 요청 계속 진행
+// - 새 토큰은 X-Access-Token 헤더로 전달 (BFF 모드는 access 쿠키 교체)
+// - DPoP 토큰은 proof 키 확인이 필요하므로 대상 아님
+func RenewExpiredAccess(renewer AccessRenewer) AuthOption {
+	return func(o *authOptions) {
+		o.renewer = renewer
+	}
 }
 
-func (cfg *AuthMiddlewareConfig) AuthMiddleware(jwtSvc *auth.JwtService) fiber.Handler {
+func (cfg *AuthMiddlewareConfig) AuthMiddleware(jwtSvc *auth.JwtService, opts ...AuthOption) fiber.Handler {
+	options := &authOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	return func(c *fiber.Ctx) error {
 
-		// Authorization 헤더에서 Bearer 토큰 추출
-		access := auth.ExtractBearer(c.Get("Authorization"))
+		// Authorization 헤더에서 Bearer 또는 DPoP 토큰 추출
+		authHeader := c.Get("Authorization")
+		scheme := auth.TokenSchemeBearer
+		access := auth.ExtractBearer(authHeader)
+		if access == "" {
+			if access = auth.ExtractDPoP(authHeader); access != "" {
+				scheme = auth.TokenSchemeDPoP
+			}
+		}
+
+		// BFF 모드: 헤더가 없으면 HttpOnly access 쿠키 사용
+		if access == "" {
+			if access = cfg.Cookies.GetAccessCookie(c); access != "" {
+				scheme = tokenSenderCookie
+			}
+		}
 
 		// refresh 토큰은 HttpOnly 쿠키에서만 읽음
-		refresh := c.Cookies(cfg.CookieName)
+		refresh := cfg.Cookies.GetCookie(c)
 
 		// access token이 있는 경우
 		if access != "" {
@@ -31,40 +119,218 @@ func (cfg *AuthMiddlewareConfig) AuthMiddleware(jwtSvc *auth.JwtService) fiber.H
 			// Valid   : 정상
 			// Expired : 만료
 			// Invalid : 위조 / 서명 불일치 / 형식 오류
-			status := jwtSvc.VerifyStatus(access)
+			status := jwtSvc.VerifyStatus(c.UserContext(), access)
 
 			switch status {
 
 			case auth.TokenValid:
 				// 정상 토큰인 경우
-				claims := jwtSvc.Claims(access)
-				if claims == nil {
-					return c.Status(401).JSON(response.Error("INVALID_TOKEN", "Invalid token", nil))
-				}
-				c.Locals("claims", claims)
-				return c.Next()
+				return cfg.accept(c, jwtSvc, options, access, scheme)
 
 			case auth.TokenExpired:
 				// access 토큰은 만료되었지만, refresh 토큰이 있고 유효
 				if refresh != "" && jwtSvc.Verify(refresh) == nil {
-					return c.Status(401).JSON(response.Error("ACCESS_EXPIRED", "Access token expired", nil))
+					if options.renewer != nil && scheme != auth.TokenSchemeDPoP {
+						return cfg.renew(c, jwtSvc, options, refresh, scheme)
+					}
+					return cfg.fail(c, 401, scheme, "ACCESS_EXPIRED", "Access token expired", authErrorInvalidToken)
 				}
 
 				// refresh 토큰도 없거나 만료
-				return c.Status(401).JSON(response.Error("SESSION_EXPIRED", "Session expired", nil))
+				return cfg.fail(c, 401, scheme, "SESSION_EXPIRED", "Session expired", authErrorInvalidToken)
 
 			case auth.TokenInvalid:
 				// access 토큰이 위조되었거나 조작됨
-				return c.Status(401).JSON(response.Error("INVALID_TOKEN", "Invalid token", nil))
+				return cfg.fail(c, 401, scheme, "INVALID_TOKEN", "Invalid token", authErrorInvalidToken)
 			}
 		}
 
+		// 선택 인증: 토큰이 없으면 익명으로 진행
+		if options.optional {
+			return c.Next()
+		}
+
+		// 자격 증명이 없는 요청은 error 속성 없이 챌린지만 전달 (RFC 6750 3.1)
 		// access는 없지만 refresh가 있고 유효한 경우
 		if refresh != "" && jwtSvc.Verify(refresh) == nil {
-			return c.Status(401).JSON(response.Error("ACCESS_REQUIRED", "Access token required", nil))
+			return cfg.fail(c, 401, scheme, "ACCESS_REQUIRED", "Access token required", "")
 		}
 
 		// access도 없고 refresh도 없거나 만료
-		return c.Status(401).JSON(response.Error("SESSION_EXPIRED", "Session expired", nil))
+		return cfg.fail(c, 401, scheme, "SESSION_EXPIRED", "Session expired", "")
+	}
+}
+
+// 유효한 access 토큰의 Claims 확인 후 요청 진행
+func (cfg *AuthMiddlewareConfig) accept(c *fiber.Ctx, jwtSvc *auth.JwtService, options *authOptions, access string, scheme string) error {
+	claims := jwtSvc.Claims(c.UserContext(), access)
+	if claims == nil {
+		return cfg.fail(c, 401, scheme, "INVALID_TOKEN", "Invalid token", authErrorInvalidToken)
 	}
+
+	// 회원이 없는 토큰(client_credentials)은 회원 API 사용 불가
+	if claims.MemberID == 0 {
+		return cfg.fail(c, 401, scheme, "INVALID_TOKEN", "Invalid token", authErrorInvalidToken)
+	}
+
+	// OAuth 클라이언트 토큰은 허용된 라우트(userinfo) 외 사용 불가
+	if claims.ClientID != "" && !options.allowClientTokens {
+		return cfg.fail(c, 401, scheme, "INVALID_TOKEN", "Invalid token", authErrorInvalidToken)
+	}
+
+	// 토큰 전송자 검증 (DPoP proof / Bearer 허용 여부)
+	if err := cfg.verifySender(c, scheme, access, claims); err != nil {
+		authError := authErrorInvalidDPoPProof
+		if err == auth.ErrDPoPRequired {
+			authError = authErrorInvalidToken
+		}
+		return cfg.fail(c, 401, scheme, err.Error(), "Invalid DPoP proof", authError)
+	}
+
+	// 쿠키로 인증된 상태 변경 요청은 CSRF 토큰 필요
+	if scheme == tokenSenderCookie && auth.IsStateChanging(c) && !cfg.Cookies.VerifyCsrf(c) {
+		return c.Status(403).JSON(response.Error(auth.ErrCsrfTokenInvalid.Error(), "CSRF token invalid", nil))
+	}
+
+	// 비밀번호 만료 토큰은 비밀번호 변경 라우트에서만 허용
+	if claims.Scope == auth.ScopePasswordChange && !options.allowPasswordExpired {
+		return cfg.fail(c, 403, scheme, "PASSWORD_EXPIRED", "Password expired", authErrorInsufficientScope)
+	}
+	c.Locals(auth.ClaimsKey, claims)
+	return c.Next()
+}
+
+// 만료 access 토큰 자동 재발급 (회원 상태 / 세션 만료 / 비밀번호 만료는 AuthService 에서 확인)
+// - Bearer 헤더 / BFF 쿠키만 대상, 새 토큰은 같은 방식으로 전달
+func (cfg *AuthMiddlewareConfig) renew(c *fiber.Ctx, jwtSvc *auth.JwtService, options *authOptions, refresh string, scheme string) error {
+	resp, err := options.renewer.Refresh(c.UserContext(), refresh, "")
+	if err != nil {
+		dbmetrics.AccessTokenRenewalsTotal.WithLabelValues("failed").Inc()
+
+		switch err {
+		case auth.ErrSessionExpired:
+			// refresh 토큰은 유효하지만 세션이 폐기 / 만료됨 (로그아웃, 비밀번호 변경, 상태 전이)
+			// ACCESS_EXPIRED 로 응답하면 클라이언트가 성공할 수 없는 refresh 를 재시도하므로 구분, 쿠키도 정리
+			cfg.Cookies.RemoveSessionCookies(c)
+			return cfg.fail(c, 401, scheme, auth.ErrLoginRequired.Error(), "Login required", authErrorInvalidToken)
+		case auth.ErrMemberDisabled, auth.ErrPasswordExpired:
+			return cfg.fail(c, 401, scheme, err.Error(), "Access token renewal rejected", authErrorInvalidToken)
+		default:
+			return cfg.fail(c, 401, scheme, "ACCESS_EXPIRED", "Access token expired", authErrorInvalidToken)
+		}
+	}
+	dbmetrics.AccessTokenRenewalsTotal.WithLabelValues("renewed").Inc()
+
+	if scheme == tokenSenderCookie {
+		cfg.Cookies.SetAccessCookie(c, resp.AccessToken)
+	} else {
+		c.Set(HeaderRenewedAccessToken, resp.AccessToken)
+	}
+
+	return cfg.accept(c, jwtSvc, options, resp.AccessToken, scheme)
+}
+
+// 민감 작업 전 최근 자격 증명 확인 요구 (AuthMiddleware 이후)
+// - auth_time 이 재인증 유효 시간을 넘으면 REAUTH_REQUIRED (프론트는 재인증 화면 표시)
+func (cfg *AuthMiddlewareConfig) RequireRecentAuth(jwtSvc *auth.JwtService) fiber.Handler {
+	maxAge := `max_age=` + strconv.Itoa(jwtSvc.ReauthExpiresIn())
+
+	return func(c *fiber.Ctx) error {
+		claims := auth.ClaimsFrom(c)
+		if jwtSvc.RecentlyAuthenticated(claims) {
+			return c.Next()
+		}
+
+		scheme := auth.TokenSchemeBearer
+		if claims != nil && claims.BoundKey() != "" {
+			scheme = auth.TokenSchemeDPoP
+		}
+
+		c.Set(fiber.HeaderWWWAuthenticate, cfg.challenge(scheme, authErrorInsufficientUserAuthentication, "Re-authentication required", maxAge))
+		return c.Status(401).JSON(response.Error(auth.ErrReauthRequired.Error(), "Re-authentication required", nil))
+	}
+}
+
+// 인증 실패 응답 (공통 응답 코드 + WWW-Authenticate 챌린지)
+// authError 가 "" 이면 자격 증명 없는 요청 (error 속성 생략)
+func (cfg *AuthMiddlewareConfig) fail(c *fiber.Ctx, status int, scheme string, code string, message string, authError string) error {
+	c.Set(fiber.HeaderWWWAuthenticate, cfg.challenge(scheme, authError, message))
+	return c.Status(status).JSON(response.Error(code, message, nil))
+}
+
+// 허용 스킴별 챌린지 (error 속성은 요청이 사용한 스킴에만 추가)
+// - 쿠키 요청은 Bearer 챌린지로 전달
+// - Bearer 를 허용하지 않으면 Bearer 요청의 에러도 DPoP 챌린지로 전달
+func (cfg *AuthMiddlewareConfig) challenge(scheme string, authError string, description string, params ...string) string {
+	if scheme == tokenSenderCookie {
+		scheme = auth.TokenSchemeBearer
+	}
+
+	allowBearer := cfg.DPoP.AllowBearer()
+	if scheme == auth.TokenSchemeBearer && !allowBearer {
+		scheme = auth.TokenSchemeDPoP
+	}
+
+	var challenges []string
+	if cfg.DPoP.Enabled() {
+		challenge := auth.TokenSchemeDPoP + ` algs="` + strings.Join(cfg.DPoP.Algorithms(), " ") + `"`
+		if scheme == auth.TokenSchemeDPoP {
+			challenge += challengeParams(authError, description, params)
+		}
+		challenges = append(challenges, challenge)
+	}
+	if allowBearer {
+		challenge := auth.TokenSchemeBearer + ` realm="api"`
+		if scheme == auth.TokenSchemeBearer {
+			challenge += challengeParams(authError, description, params)
+		}
+		challenges = append(challenges, challenge)
+	}
+
+	return strings.Join(challenges, ", ")
+}
+
+// error / error_description / 추가 속성
+func challengeParams(authError string, description string, params []string) string {
+	if authError == "" {
+		return ""
+	}
+
+	result := `, error="` + authError + `", error_description="` + description + `"`
+	for _, param := range params {
+		result += ", " + param
+	}
+	return result
+}
+
+// 토큰 전송자 검증 (RFC 9449)
+// - DPoP 스킴: proof 서명, htm/htu, iat, jti 재사용, ath 확인 후 토큰의 cnf.jkt 와 비교
+// - Bearer 스킴: DPoP 바인딩 토큰 사용 불가, 설정에 따라 Bearer 자체 거부
+// - BFF 쿠키: DPoP 바인딩 토큰 사용 불가, Bearer 허용 여부와 무관 (CSRF 검증은 accept 에서)
+func (cfg *AuthMiddlewareConfig) verifySender(c *fiber.Ctx, scheme string, access string, claims *auth.Claims) error {
+	bound := claims.BoundKey()
+
+	if scheme == tokenSenderCookie {
+		if bound != "" {
+			return auth.ErrDPoPRequired
+		}
+		return nil
+	}
+
+	if scheme == auth.TokenSchemeBearer {
+		if bound != "" || !cfg.DPoP.AllowBearer() {
+			return auth.ErrDPoPRequired
+		}
+		return nil
+	}
+
+	jkt, err := cfg.DPoP.VerifyProof(c.Get("DPoP"), c.Method(), c.BaseURL()+c.OriginalURL(), access)
+	if err != nil {
+		return err
+	}
+	if bound != jkt {
+		return auth.ErrDPoPKeyMismatch
+	}
+
+	return nil
 }
@@ -0,0 +1,486 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"study/internal/config"
+	"study/internal/feature/auth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 고정 결과 재발급기
+type stubRenewer struct {
+	jwtSvc *auth.JwtService
+	err    error
+	calls  int
+}
+
+func (r *stubRenewer) Refresh(ctx context.Context, refreshToken string, jkt string) (*auth.LoginResponse, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	accessToken, err := r.jwtSvc.GenerateAccessToken(ctx, 1, "")
+	if err != nil {
+		return nil, err
+	}
+	return &auth.LoginResponse{AccessToken: accessToken, TokenType: auth.TokenSchemeBearer}, nil
+}
+
+func TestAuthMiddlewareRenewExpired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtSvc := auth.NewJwtService(&config.JWT{
+		AccessSecret:     []byte("ACCESS_SECRET_KEY"),
+		RefreshSecret:    []byte("REFRESH_SECRET_KEY"),
+		AccessExpireMin:  30,
+		RefreshExpireDay: 14,
+		Issuer:           "study",
+		Audience:         []string{"study-api"},
+	}, auth.WithClock(func() time.Time { return now }))
+
+	cookies, err := auth.NewCookieService(&config.Cookie{Name: "SH_REFRESH", Path: "/", SameSite: "Lax", Mode: auth.CookieModeToken})
+	if err != nil {
+		t.Fatalf("CookieService 생성 실패: %v", err)
+	}
+	mw := NewAuthMiddlewareConfig(cookies, auth.NewDPoPVerifier(&config.DPoP{}))
+
+	login, err := jwtSvc.Login(context.Background(), 1, "session", "", nil)
+	if err != nil {
+		t.Fatalf("로그인 토큰 생성 실패: %v", err)
+	}
+
+	// access 토큰만 만료
+	now = now.Add(time.Hour)
+
+	request := func(opts ...AuthOption) func() (int, string, string) {
+		app := fiber.New()
+		app.Get("/me", mw.AuthMiddleware(jwtSvc, opts...), func(c *fiber.Ctx) error {
+			return c.JSON(fiber.Map{"code": "OK"})
+		})
+
+		return func() (int, string, string) {
+			req := httptest.NewRequest(fiber.MethodGet, "/me", nil)
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+login.AccessToken)
+			req.Header.Set(fiber.HeaderCookie, "SH_REFRESH="+login.RefreshToken)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("요청 실패: %v", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+
+			var payload struct {
+				Code string `json:"code"`
+			}
+			_ = json.Unmarshal(body, &payload)
+			return resp.StatusCode, payload.Code, resp.Header.Get(HeaderRenewedAccessToken)
+		}
+	}
+
+	// 기본: 재발급하지 않음
+	do := request()
+	if status, code, _ := do(); status != fiber.StatusUnauthorized || code != "ACCESS_EXPIRED" {
+		t.Errorf("ACCESS_EXPIRED 기대, 결과: %d %s", status, code)
+	}
+
+	// 재발급 모드: 새 토큰으로 요청 진행
+	renewer := &stubRenewer{jwtSvc: jwtSvc}
+	do = request(RenewExpiredAccess(renewer))
+	status, code, renewed := do()
+	if status != fiber.StatusOK || code != "OK" {
+		t.Fatalf("재발급 후 요청 진행 실패: %d %s", status, code)
+	}
+	if renewed == "" || jwtSvc.VerifyStatus(context.Background(), renewed) != auth.TokenValid {
+		t.Errorf("재발급 토큰 헤더 누락 또는 무효: %q", renewed)
+	}
+
+	// 비활성 회원은 재발급 거부
+	renewer = &stubRenewer{jwtSvc: jwtSvc, err: auth.ErrMemberDisabled}
+	do = request(RenewExpiredAccess(renewer))
+	if status, code, renewed := do(); status != fiber.StatusUnauthorized || code != auth.ErrMemberDisabled.Error() || renewed != "" {
+		t.Errorf("MEMBER_DISABLED 기대, 결과: %d %s %q", status, code, renewed)
+	}
+
+	// 폐기된 세션: 재시도할 수 없으므로 LOGIN_REQUIRED + refresh 쿠키 삭제
+	app := fiber.New()
+	app.Get("/me", mw.AuthMiddleware(jwtSvc, RenewExpiredAccess(&stubRenewer{jwtSvc: jwtSvc, err: auth.ErrSessionExpired})), func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"code": "OK"})
+	})
+	req := httptest.NewRequest(fiber.MethodGet, "/me", nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer "+login.AccessToken)
+	req.Header.Set(fiber.HeaderCookie, "SH_REFRESH="+login.RefreshToken)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("요청 실패: %v", err)
+	}
+	var payload struct {
+		Code string `json:"code"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	if resp.StatusCode != fiber.StatusUnauthorized || payload.Code != auth.ErrLoginRequired.Error() {
+		t.Errorf("LOGIN_REQUIRED 기대, 결과: %d %s", resp.StatusCode, payload.Code)
+	}
+	cleared := false
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "SH_REFRESH" && cookie.Value == "" && cookie.Expires.Before(now) {
+			cleared = true
+		}
+	}
+	if !cleared {
+		t.Errorf("refresh 쿠키 삭제 누락: %v", resp.Header.Values(fiber.HeaderSetCookie))
+	}
+}
+
+func TestAuthMiddlewareOptionalAndChallenge(t *testing.T) {
+	jwtSvc := auth.NewJwtService(&config.JWT{
+		AccessSecret:     []byte("ACCESS_SECRET_KEY"),
+		RefreshSecret:    []byte("REFRESH_SECRET_KEY"),
+		AccessExpireMin:  30,
+		RefreshExpireDay: 14,
+		Issuer:           "study",
+		Audience:         []string{"study-api"},
+	})
+
+	cookies, err := auth.NewCookieService(&config.Cookie{Name: "SH_REFRESH", Path: "/", SameSite: "Lax", Mode: auth.CookieModeToken})
+	if err != nil {
+		t.Fatalf("CookieService 생성 실패: %v", err)
+	}
+	mw := NewAuthMiddlewareConfig(cookies, auth.NewDPoPVerifier(&config.DPoP{}))
+
+	accessToken, err := jwtSvc.GenerateAccessToken(context.Background(), 1, "")
+	if err != nil {
+		t.Fatalf("access 토큰 생성 실패: %v", err)
+	}
+
+	app := fiber.New()
+	app.Get("/feed", mw.AuthMiddleware(jwtSvc, OptionalAuth()), func(c *fiber.Ctx) error {
+		if claims := auth.ClaimsFrom(c); claims != nil {
+			return c.JSON(fiber.Map{"code": "MEMBER"})
+		}
+		return c.JSON(fiber.Map{"code": "ANONYMOUS"})
+	})
+	app.Get("/me", mw.AuthMiddleware(jwtSvc), func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"code": "OK"})
+	})
+
+	do := func(path string, token string) (int, string, string) {
+		req := httptest.NewRequest(fiber.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+		}
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("요청 실패: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+
+		var payload struct {
+			Code string `json:"code"`
+		}
+		_ = json.Unmarshal(body, &payload)
+		return resp.StatusCode, payload.Code, resp.Header.Get(fiber.HeaderWWWAuthenticate)
+	}
+
+	cases := []struct {
+		name      string
+		path      string
+		token     string
+		status    int
+		code      string
+		challenge string
+	}{
+		{"선택 인증 익명", "/feed", "", fiber.StatusOK, "ANONYMOUS", ""},
+		{"선택 인증 회원", "/feed", accessToken, fiber.StatusOK, "MEMBER", ""},
+		{"선택 인증 잘못된 토큰", "/feed", "invalid", fiber.StatusUnauthorized, "INVALID_TOKEN",
+			`Bearer realm="api", error="invalid_token", error_description="Invalid token"`},
+		{"필수 인증 토큰 없음", "/me", "", fiber.StatusUnauthorized, "SESSION_EXPIRED", `Bearer realm="api"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, code, challenge := do(tc.path, tc.token)
+			if status != tc.status || code != tc.code {
+				t.Errorf("응답 불일치: %d %s, 기대: %d %s", status, code, tc.status, tc.code)
+			}
+			if challenge != tc.challenge {
+				t.Errorf("WWW-Authenticate 불일치: %q, 기대: %q", challenge, tc.challenge)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareClientTokens(t *testing.T) {
+	jwtSvc := auth.NewJwtService(&config.JWT{
+		AccessSecret:     []byte("ACCESS_SECRET_KEY"),
+		RefreshSecret:    []byte("REFRESH_SECRET_KEY"),
+		AccessExpireMin:  30,
+		RefreshExpireDay: 14,
+		Issuer:           "study",
+		Audience:         []string{"study-api"},
+	})
+
+	cookies, err := auth.NewCookieService(&config.Cookie{Name: "SH_REFRESH", Path: "/", SameSite: "Lax", Mode: auth.CookieModeToken})
+	if err != nil {
+		t.Fatalf("CookieService 생성 실패: %v", err)
+	}
+	mw := NewAuthMiddlewareConfig(cookies, auth.NewDPoPVerifier(&config.DPoP{}))
+
+	memberToken, err := jwtSvc.GenerateAccessToken(context.Background(), 1, "")
+	if err != nil {
+		t.Fatalf("access 토큰 생성 실패: %v", err)
+	}
+	clientToken, err := jwtSvc.GenerateClientAccessToken(context.Background(), 1, "client", "openid")
+	if err != nil {
+		t.Fatalf("클라이언트 토큰 생성 실패: %v", err)
+	}
+
+	app := fiber.New()
+	ok := func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	}
+	app.Get("/userinfo", mw.AuthMiddleware(jwtSvc, AllowClientTokens()), ok)
+	app.Get("/admin", mw.AuthMiddleware(jwtSvc), ok)
+
+	cases := []struct {
+		name   string
+		path   string
+		token  string
+		status int
+	}{
+		{"회원 토큰", "/admin", memberToken, fiber.StatusOK},
+		{"클라이언트 토큰 1st-party 거부", "/admin", clientToken, fiber.StatusUnauthorized},
+		{"클라이언트 토큰 userinfo 허용", "/userinfo", clientToken, fiber.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, tc.path, nil)
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tc.token)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("요청 실패: %v", err)
+			}
+			if resp.StatusCode != tc.status {
+				t.Errorf("상태 코드 불일치: %d, 기대: %d", resp.StatusCode, tc.status)
+			}
+		})
+	}
+}
+
+func TestRequireRecentAuth(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtSvc := auth.NewJwtService(&config.JWT{
+		AccessSecret:     []byte("ACCESS_SECRET_KEY"),
+		RefreshSecret:    []byte("REFRESH_SECRET_KEY"),
+		AccessExpireMin:  30,
+		RefreshExpireDay: 14,
+		Issuer:           "study",
+		Audience:         []string{"study-api"},
+		ReauthMaxAgeMin:  5,
+	}, auth.WithClock(func() time.Time { return now }))
+
+	cookies, err := auth.NewCookieService(&config.Cookie{Name: "SH_REFRESH", Path: "/", SameSite: "Lax", Mode: auth.CookieModeToken})
+	if err != nil {
+		t.Fatalf("CookieService 생성 실패: %v", err)
+	}
+	mw := NewAuthMiddlewareConfig(cookies, auth.NewDPoPVerifier(&config.DPoP{}))
+
+	app := fiber.New()
+	app.Delete("/sessions", mw.AuthMiddleware(jwtSvc), mw.RequireRecentAuth(jwtSvc), func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"code": "OK"})
+	})
+
+	do := func(token string) (int, string, string) {
+		req := httptest.NewRequest(fiber.MethodDelete, "/sessions", nil)
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("요청 실패: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+
+		var payload struct {
+			Code string `json:"code"`
+		}
+		_ = json.Unmarshal(body, &payload)
+		return resp.StatusCode, payload.Code, resp.Header.Get(fiber.HeaderWWWAuthenticate)
+	}
+
+	ctx := context.Background()
+	login, err := jwtSvc.Login(ctx, 1, "session", "", nil)
+	if err != nil {
+		t.Fatalf("로그인 토큰 생성 실패: %v", err)
+	}
+	loginClaims := jwtSvc.Claims(ctx, login.AccessToken)
+	noAuthTime, err := jwtSvc.GenerateAccessToken(ctx, 1, "")
+	if err != nil {
+		t.Fatalf("access 토큰 생성 실패: %v", err)
+	}
+
+	// 로그인 직후는 허용
+	if status, code, _ := do(login.AccessToken); status != fiber.StatusOK || code != "OK" {
+		t.Errorf("로그인 직후 요청 거부: %d %s", status, code)
+	}
+
+	// 재인증 유효 시간 경과 (refresh 재발급 토큰도 로그인 시각 유지)
+	now = now.Add(10 * time.Minute)
+	stale, err := jwtSvc.GenerateAccessTokenAt(ctx, 1, "", loginClaims.AuthTime, nil)
+	if err != nil {
+		t.Fatalf("access 토큰 재발급 실패: %v", err)
+	}
+	wantChallenge := `Bearer realm="api", error="insufficient_user_authentication", error_description="Re-authentication required", max_age=300`
+	for name, token := range map[string]string{"오래된 로그인": stale, "auth_time 없음": noAuthTime} {
+		status, code, challenge := do(token)
+		if status != fiber.StatusUnauthorized || code != auth.ErrReauthRequired.Error() {
+			t.Errorf("%s: REAUTH_REQUIRED 기대, 결과: %d %s", name, status, code)
+		}
+		if challenge != wantChallenge {
+			t.Errorf("%s: WWW-Authenticate 불일치: %q", name, challenge)
+		}
+	}
+
+	// 재인증 토큰은 허용
+	elevated, err := jwtSvc.GenerateElevatedAccessToken(ctx, 1, "", nil)
+	if err != nil {
+		t.Fatalf("재인증 토큰 생성 실패: %v", err)
+	}
+	if status, code, _ := do(elevated); status != fiber.StatusOK || code != "OK" {
+		t.Errorf("재인증 토큰 요청 거부: %d %s", status, code)
+	}
+}
+
+func TestAuthMiddlewareCookieSender(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jwtSvc := auth.NewJwtService(&config.JWT{
+		AccessSecret:     []byte("ACCESS_SECRET_KEY"),
+		RefreshSecret:    []byte("REFRESH_SECRET_KEY"),
+		AccessExpireMin:  30,
+		RefreshExpireDay: 14,
+		Issuer:           "study",
+		Audience:         []string{"study-api"},
+	}, auth.WithClock(func() time.Time { return now }))
+
+	cookies, err := auth.NewCookieService(&config.Cookie{
+		Name:       "SH_REFRESH",
+		Path:       "/",
+		SameSite:   "Lax",
+		Mode:       auth.CookieModeBFF,
+		AccessName: "SH_ACCESS",
+		CsrfName:   "XSRF-TOKEN",
+		CsrfHeader: "X-CSRF-Token",
+	})
+	if err != nil {
+		t.Fatalf("CookieService 생성 실패: %v", err)
+	}
+	// Bearer 헤더는 허용하지 않아도 BFF 쿠키는 동작
+	mw := NewAuthMiddlewareConfig(cookies, auth.NewDPoPVerifier(&config.DPoP{Enabled: true, AllowBearer: false}))
+
+	ctx := context.Background()
+	login, err := jwtSvc.Login(ctx, 1, "session", "", nil)
+	if err != nil {
+		t.Fatalf("로그인 토큰 생성 실패: %v", err)
+	}
+	bound, err := jwtSvc.GenerateAccessToken(ctx, 1, "jkt")
+	if err != nil {
+		t.Fatalf("DPoP 바인딩 토큰 생성 실패: %v", err)
+	}
+
+	renewer := &stubRenewer{jwtSvc: jwtSvc}
+	app := fiber.New()
+	handler := func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"code": "OK"})
+	}
+	app.Get("/me", mw.AuthMiddleware(jwtSvc, RenewExpiredAccess(renewer)), handler)
+	app.Post("/me", mw.AuthMiddleware(jwtSvc, RenewExpiredAccess(renewer)), handler)
+
+	type request struct {
+		method string
+		header string // Authorization 헤더
+		access string // access 쿠키
+		csrf   string // CSRF 쿠키
+		token  string // CSRF 헤더
+	}
+	do := func(r request) (int, string, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(r.method, "/me", nil)
+		if r.header != "" {
+			req.Header.Set(fiber.HeaderAuthorization, r.header)
+		}
+		cookie := "SH_REFRESH=" + login.RefreshToken
+		if r.access != "" {
+			cookie += "; SH_ACCESS=" + r.access
+		}
+		if r.csrf != "" {
+			cookie += "; XSRF-TOKEN=" + r.csrf
+		}
+		req.Header.Set(fiber.HeaderCookie, cookie)
+		if r.token != "" {
+			req.Header.Set("X-CSRF-Token", r.token)
+		}
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("요청 실패: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+
+		var payload struct {
+			Code string `json:"code"`
+		}
+		_ = json.Unmarshal(body, &payload)
+
+		recorder := httptest.NewRecorder()
+		for _, v := range resp.Header.Values(fiber.HeaderSetCookie) {
+			recorder.Header().Add(fiber.HeaderSetCookie, v)
+		}
+		return resp.StatusCode, payload.Code, recorder
+	}
+
+	cases := []struct {
+		name   string
+		req    request
+		status int
+		code   string
+	}{
+		{"쿠키 조회 요청", request{method: fiber.MethodGet, access: login.AccessToken}, fiber.StatusOK, "OK"},
+		{"쿠키 변경 요청 CSRF 누락", request{method: fiber.MethodPost, access: login.AccessToken}, fiber.StatusForbidden, auth.ErrCsrfTokenInvalid.Error()},
+		{"쿠키 변경 요청 CSRF 불일치", request{method: fiber.MethodPost, access: login.AccessToken, csrf: "csrf", token: "other"}, fiber.StatusForbidden, auth.ErrCsrfTokenInvalid.Error()},
+		{"쿠키 변경 요청 CSRF 일치", request{method: fiber.MethodPost, access: login.AccessToken, csrf: "csrf", token: "csrf"}, fiber.StatusOK, "OK"},
+		{"DPoP 바인딩 토큰 쿠키 거부", request{method: fiber.MethodGet, access: bound}, fiber.StatusUnauthorized, auth.ErrDPoPRequired.Error()},
+		{"Bearer 헤더 거부", request{method: fiber.MethodGet, header: "Bearer " + login.AccessToken}, fiber.StatusUnauthorized, auth.ErrDPoPRequired.Error()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if status, code, _ := do(tc.req); status != tc.status || code != tc.code {
+				t.Errorf("응답 불일치: %d %s, 기대: %d %s", status, code, tc.status, tc.code)
+			}
+		})
+	}
+
+	// access 만료: refresh 쿠키로 재발급 후 access 쿠키 교체
+	now = now.Add(time.Hour)
+	status, code, recorder := do(request{method: fiber.MethodGet, access: login.AccessToken})
+	if status != fiber.StatusOK || code != "OK" || renewer.calls != 1 {
+		t.Fatalf("쿠키 토큰 재발급 실패: %d %s (calls=%d)", status, code, renewer.calls)
+	}
+
+	var renewed string
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == "SH_ACCESS" {
+			renewed = cookie.Value
+		}
+	}
+	if renewed == "" || jwtSvc.VerifyStatus(ctx, renewed) != auth.TokenValid {
+		t.Errorf("재발급 access 쿠키 누락 또는 무효: %q", renewed)
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"io"
+
+	"study/internal/shared/errorx"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 요청 본문 크기 제한 (fiber StreamRequestBody 사용 시)
+// - 스트리밍 모드에서는 fasthttp 가 큰 본문을 거부하지 않고 핸들러에 스트림으로 넘기므로 여기서 제한
+// - 일반 경로: limit 이하 본문을 미리 모두 읽음 (스트리밍 이전과 동일, 초과 시 413)
+// - streamRoutes("METHOD /path"): 본문을 읽지 않고 핸들러에 넘김 (크기 제한은 핸들러에서)
+// - 스트리밍 경로는 핸들러가 본문을 끝까지 읽지 않고 응답할 수 있으므로 응답 후 연결 종료
+func BodyLimit(limit int, streamRoutes ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		for _, route := range streamRoutes {
+			if route == c.Method()+" "+c.Path() {
+				c.Context().SetConnectionClose()
+				return c.Next()
+			}
+		}
+
+		req := c.Request()
+		if req.Header.ContentLength() > limit {
+			return bodyTooLarge(c)
+		}
+
+		// Content-Length 가 있으면 그 크기까지, chunked 는 limit 까지만 읽음
+		if req.IsBodyStream() {
+			body, err := io.ReadAll(io.LimitReader(req.BodyStream(), int64(limit)+1))
+			if err != nil {
+				c.Context().SetConnectionClose()
+				return c.Status(fiber.StatusBadRequest).JSON(response.Error(errorx.ErrRequestParseFailed.Error(), "요청 본문 읽기 실패", nil))
+			}
+			if len(body) > limit {
+				return bodyTooLarge(c)
+			}
+			req.SetBody(body)
+		}
+
+		return c.Next()
+	}
+}
+
+// 413 (남은 본문은 읽지 않으므로 연결 종료)
+func bodyTooLarge(c *fiber.Ctx) error {
+	c.Context().SetConnectionClose()
+	return c.Status(fiber.StatusRequestEntityTooLarge).JSON(response.Error(errorx.ErrBodyTooLarge.Error(), "요청 본문 크기 초과", nil))
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestBodyLimit(t *testing.T) {
+	const limit = 16
+
+	app := fiber.New(fiber.Config{BodyLimit: limit, StreamRequestBody: true, DisablePreParseMultipartForm: true})
+	app.Use(BodyLimit(limit, fiber.MethodPost+" /upload"))
+	app.Post("/echo", func(c *fiber.Ctx) error {
+		return c.Send(c.Body())
+	})
+	app.Post("/upload", func(c *fiber.Ctx) error {
+		n, err := io.Copy(io.Discard, c.Request().BodyStream())
+		if err != nil {
+			return err
+		}
+		return c.SendString(strconv.FormatInt(n, 10))
+	})
+
+	cases := []struct {
+		name    string
+		path    string
+		body    io.Reader
+		chunked bool
+		status  int
+		want    string
+	}{
+		{"제한 이하", "/echo", strings.NewReader("hello"), false, fiber.StatusOK, "hello"},
+		{"제한 초과", "/echo", strings.NewReader(strings.Repeat("a", limit+1)), false, fiber.StatusRequestEntityTooLarge, ""},
+		{"chunked 제한 이하", "/echo", strings.NewReader("hello"), true, fiber.StatusOK, "hello"},
+		{"chunked 제한 초과", "/echo", strings.NewReader(strings.Repeat("a", 64*1024)), true, fiber.StatusRequestEntityTooLarge, ""},
+		{"스트리밍 경로", "/upload", strings.NewReader(strings.Repeat("a", 64*1024)), false, fiber.StatusOK, "65536"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodPost, tc.path, tc.body)
+			if tc.chunked {
+				req.ContentLength = -1
+				req.TransferEncoding = []string{"chunked"}
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("요청 실패: %v", err)
+			}
+			if resp.StatusCode != tc.status {
+				t.Fatalf("상태 코드 불일치: %d, 기대: %d", resp.StatusCode, tc.status)
+			}
+			if tc.want != "" {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != tc.want {
+					t.Errorf("본문 불일치: %q, 기대: %q", body, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ func Cors(cfg *config.Cors) fiber.Handler {
 		},
 		AllowMethods:     strings.Join(cfg.AllowMethods, ","),
 		AllowHeaders:     strings.Join(cfg.AllowHeaders, ","),
+		ExposeHeaders:    strings.Join(cfg.ExposeHeaders, ","),
 		AllowCredentials: cfg.AllowCredentials,
 	})
 }
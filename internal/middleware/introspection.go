@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"study/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+)
+
+// 토큰 인트로스펙션 호출자(리소스 서버) 인증 (HTTP Basic)
+func IntrospectionAuth(cfg *config.Introspection) fiber.Handler {
+	users := make(map[string]string, len(cfg.Clients))
+	for _, client := range cfg.Clients {
+		// 시크릿이 없는 클라이언트는 빈 비밀번호로 통과하지 않도록 제외
+		if secret := cfg.Secrets[client.ID]; secret != "" {
+			users[client.ID] = secret
+		}
+	}
+
+	return basicauth.New(basicauth.Config{
+		Users: users,
+		Realm: "introspection",
+		Unauthorized: func(c *fiber.Ctx) error {
+			c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="introspection"`)
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid_client"})
+		},
+	})
+}
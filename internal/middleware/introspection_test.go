@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"testing"
+
+	"study/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestIntrospectionAuth(t *testing.T) {
+	app := fiber.New()
+	app.Post("/introspect", IntrospectionAuth(&config.Introspection{
+		Clients: []config.IntrospectionClient{{ID: "resource-server"}, {ID: "unconfigured"}},
+		Secrets: map[string]string{"resource-server": "resource-server-secret"},
+	}), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	basic := func(id string, secret string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(id+":"+secret))
+	}
+
+	cases := []struct {
+		name   string
+		header string
+		status int
+	}{
+		{"정상 자격 증명", basic("resource-server", "resource-server-secret"), fiber.StatusOK},
+		{"잘못된 시크릿", basic("resource-server", "wrong"), fiber.StatusUnauthorized},
+		{"시크릿 없는 클라이언트", basic("unconfigured", ""), fiber.StatusUnauthorized},
+		{"등록되지 않은 클라이언트", basic("unknown", "resource-server-secret"), fiber.StatusUnauthorized},
+		{"자격 증명 없음", "", fiber.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodPost, "/introspect", nil)
+			if tc.header != "" {
+				req.Header.Set(fiber.HeaderAuthorization, tc.header)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("요청 실패: %v", err)
+			}
+			if resp.StatusCode != tc.status {
+				t.Errorf("상태 코드 불일치: %d, 기대: %d", resp.StatusCode, tc.status)
+			}
+		})
+	}
+}
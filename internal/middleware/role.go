@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+
+	"study/internal/feature/auth"
+	"study/internal/query"
+	"study/internal/shared/errorx"
+	"study/internal/shared/model"
+	"study/pkg/log"
+	"study/pkg/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 회원 권한 조회 (*query.Queries 가 구현)
+type RoleChecker interface {
+	HasMemberRole(ctx context.Context, arg query.HasMemberRoleParams) (bool, error)
+}
+
+// 지정 권한 보유 회원만 허용 (AuthMiddleware 이후)
+// - 권한은 토큰이 아닌 DB 기준으로 확인 (회수 / 비활성화 즉시 반영)
+func RequireRole(roles RoleChecker, role model.Role) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims := auth.ClaimsFrom(c)
+		if claims == nil || claims.MemberID == 0 {
+			return c.Status(fiber.StatusUnauthorized).JSON(response.Error("INVALID_TOKEN", "Invalid token", nil))
+		}
+
+		ok, err := roles.HasMemberRole(c.UserContext(), query.HasMemberRoleParams{MemberID: claims.MemberID, Role: role})
+		if err != nil {
+			log.ErrorCtx(c.UserContext(), "권한 조회 실패", log.MapInt64("memberId", claims.MemberID), log.MapErr("error", err))
+			return c.Status(fiber.StatusInternalServerError).JSON(response.Error(errorx.ErrInternal.Error(), "Role check failed", nil))
+		}
+		if !ok {
+			return c.Status(fiber.StatusForbidden).JSON(response.Error(errorx.ErrForbidden.Error(), "Forbidden", nil))
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"study/internal/feature/auth"
+	"study/internal/query"
+	"study/internal/shared/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 고정 권한 조회기
+type stubRoles struct {
+	roles map[int64][]model.Role
+	err   error
+}
+
+func (s *stubRoles) HasMemberRole(ctx context.Context, arg query.HasMemberRoleParams) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	for _, role := range s.roles[arg.MemberID] {
+		if role == arg.Role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestRequireRole(t *testing.T) {
+	request := func(roles RoleChecker, claims *auth.Claims) int {
+		app := fiber.New()
+		app.Get("/admin", func(c *fiber.Ctx) error {
+			if claims != nil {
+				c.Locals(auth.ClaimsKey, claims)
+			}
+			return c.Next()
+		}, RequireRole(roles, model.RoleAdmin), func(c *fiber.Ctx) error {
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/admin", nil))
+		if err != nil {
+			t.Fatalf("요청 실패: %v", err)
+		}
+		return resp.StatusCode
+	}
+
+	roles := &stubRoles{roles: map[int64][]model.Role{
+		1: {model.RoleUser, model.RoleAdmin},
+		2: {model.RoleUser},
+	}}
+
+	cases := []struct {
+		name   string
+		roles  RoleChecker
+		claims *auth.Claims
+		status int
+	}{
+		{"관리자", roles, &auth.Claims{MemberID: 1}, fiber.StatusOK},
+		{"일반 회원", roles, &auth.Claims{MemberID: 2}, fiber.StatusForbidden},
+		{"인증 정보 없음", roles, nil, fiber.StatusUnauthorized},
+		{"클라이언트 토큰", roles, &auth.Claims{ClientID: "client"}, fiber.StatusUnauthorized},
+		{"권한 조회 실패", &stubRoles{err: errors.New("db down")}, &auth.Claims{MemberID: 1}, fiber.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if status := request(tc.roles, tc.claims); status != tc.status {
+				t.Errorf("상태 코드 불일치: %d, 기대: %d", status, tc.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"study/internal/config"
+	"study/internal/feature/scim"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SCIM 프로비저닝 호출자(IdP) 인증 (테넌트별 Bearer 토큰)
+func ScimAuth(cfg *config.Scim) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		const prefix = "Bearer "
+
+		header := c.Get(fiber.HeaderAuthorization)
+		if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+			c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="scim"`)
+			return scim.Error(c, scim.ErrUnauthorized)
+		}
+		token := []byte(header[len(prefix):])
+
+		// 토큰 비교는 상수 시간 (모든 테넌트 비교)
+		tenantID := ""
+		for _, tenant := range cfg.Tenants {
+			expected := cfg.Tokens[tenant.ID]
+			if expected != "" && subtle.ConstantTimeCompare(token, []byte(expected)) == 1 {
+				tenantID = tenant.ID
+			}
+		}
+
+		if tenantID == "" {
+			c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="scim", error="invalid_token"`)
+			return scim.Error(c, scim.ErrUnauthorized)
+		}
+
+		c.Locals(scim.TenantKey, tenantID)
+		return c.Next()
+	}
+}
@@ -0,0 +1,38 @@
+-- name: CreateAccessToken :exec
+INSERT INTO access_tokens (
+    token_hash,
+    member_id,
+    claims,
+    expires_at
+) VALUES (
+    @token_hash,
+    NULLIF(@member_id::bigint, 0),
+    @claims,
+    now() + make_interval(mins => @expire_min::int)
+);
+
+
+-- name: FindAccessTokenClaims :one
+SELECT claims
+FROM access_tokens
+WHERE token_hash = $1
+  AND revoked_at IS NULL;
+
+
+-- name: RevokeAccessToken :exec
+UPDATE access_tokens
+SET revoked_at = now()
+WHERE token_hash = $1
+  AND revoked_at IS NULL;
+
+
+-- name: RevokeAccessTokensByMemberID :exec
+UPDATE access_tokens
+SET revoked_at = now()
+WHERE member_id = @member_id::bigint
+  AND revoked_at IS NULL;
+
+
+-- name: DeleteExpiredAccessTokens :exec
+DELETE FROM access_tokens
+WHERE expires_at < now();
@@ -0,0 +1,70 @@
+-- name: ListAdminMembers :many
+SELECT
+    m.member_id,
+    m.email,
+    m.name,
+    m.tel,
+    m.address,
+    m.profile,
+    m.status,
+    m.created_at,
+    m.updated_at,
+    m.deleted_at,
+    m.password_changed_at
+FROM members m
+WHERE (sqlc.narg('status')::text IS NULL OR m.status = sqlc.narg('status')::text)
+  AND (m.deleted_at IS NULL OR sqlc.narg('status')::text = 'DELETED')
+  AND (sqlc.narg('role')::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM member_roles r
+        WHERE r.member_id = m.member_id
+          AND r.role = sqlc.narg('role')::text
+      ))
+  AND (sqlc.narg('created_from')::timestamp IS NULL OR m.created_at >= sqlc.narg('created_from')::timestamp)
+  AND (sqlc.narg('created_to')::timestamp IS NULL OR m.created_at < sqlc.narg('created_to')::timestamp)
+  AND (sqlc.narg('search')::text IS NULL OR m.email ILIKE '%' || sqlc.narg('search')::text || '%' OR m.name ILIKE '%' || sqlc.narg('search')::text || '%')
+ORDER BY
+    CASE WHEN @sort_key::text = 'email' AND NOT @sort_desc::boolean THEN m.email END ASC,
+    CASE WHEN @sort_key::text = 'email' AND @sort_desc::boolean THEN m.email END DESC,
+    CASE WHEN @sort_key::text = 'name' AND NOT @sort_desc::boolean THEN m.name END ASC,
+    CASE WHEN @sort_key::text = 'name' AND @sort_desc::boolean THEN m.name END DESC,
+    CASE WHEN @sort_key::text = 'created_at' AND NOT @sort_desc::boolean THEN m.created_at END ASC,
+    CASE WHEN @sort_key::text = 'created_at' AND @sort_desc::boolean THEN m.created_at END DESC,
+    CASE WHEN @sort_desc::boolean THEN m.member_id END DESC,
+    m.member_id ASC
+LIMIT @limit_count
+OFFSET @offset_count;
+
+
+-- name: CountAdminMembers :one
+SELECT count(*)
+FROM members m
+WHERE (sqlc.narg('status')::text IS NULL OR m.status = sqlc.narg('status')::text)
+  AND (m.deleted_at IS NULL OR sqlc.narg('status')::text = 'DELETED')
+  AND (sqlc.narg('role')::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM member_roles r
+        WHERE r.member_id = m.member_id
+          AND r.role = sqlc.narg('role')::text
+      ))
+  AND (sqlc.narg('created_from')::timestamp IS NULL OR m.created_at >= sqlc.narg('created_from')::timestamp)
+  AND (sqlc.narg('created_to')::timestamp IS NULL OR m.created_at < sqlc.narg('created_to')::timestamp)
+  AND (sqlc.narg('search')::text IS NULL OR m.email ILIKE '%' || sqlc.narg('search')::text || '%' OR m.name ILIKE '%' || sqlc.narg('search')::text || '%');
+
+
+-- name: ListRolesByMemberIDs :many
+SELECT
+    member_id,
+    role
+FROM member_roles
+WHERE member_id = ANY(@member_ids::bigint[])
+ORDER BY member_id, role;
+
+
+-- name: UpdateMemberStatus :exec
+UPDATE members
+SET
+    status = $2,
+    updated_at = now()
+WHERE member_id = $1
+  AND deleted_at IS NULL;
@@ -16,7 +16,8 @@ INSERT INTO member_roles (
     role
 ) VALUES (
     $1, $2
-);
+)
+ON CONFLICT (member_id, role) DO NOTHING;
 
 
 -- name: GetRolesByMemberID :many
@@ -25,6 +26,17 @@ FROM member_roles
 WHERE member_id = $1;
 
 
+-- name: HasMemberRole :one
+SELECT EXISTS (
+    SELECT 1
+    FROM member_roles r
+    JOIN members m ON m.member_id = r.member_id
+    WHERE r.member_id = $1
+      AND r.role = $2
+      AND m.status = 'ACTIVE'
+);
+
+
 -- name: FindMemberByEmail :one
 SELECT
     member_id,
@@ -37,9 +49,12 @@ SELECT
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    password_changed_at,
+    tel_verified_at
 FROM members
-WHERE email = $1;
+WHERE email = $1
+  AND deleted_at IS NULL;
 
 
 -- name: FindMemberByID :one
@@ -54,6 +69,100 @@ SELECT
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    password_changed_at,
+    tel_verified_at
 FROM members
 WHERE member_id = $1;
+
+
+-- name: UpdateMemberPassword :exec
+UPDATE members
+SET
+    password = $2,
+    password_changed_at = now(),
+    updated_at = now()
+WHERE member_id = $1;
+
+
+-- name: DeleteMemberRole :exec
+DELETE FROM member_roles
+WHERE member_id = $1
+  AND role = $2;
+
+
+-- name: SoftDeleteMember :exec
+UPDATE members
+SET
+    status = 'DELETED',
+    deleted_at = now(),
+    updated_at = now()
+WHERE member_id = $1
+  AND deleted_at IS NULL;
+
+
+-- name: UpdateMemberProfile :one
+UPDATE members
+SET
+    name = CASE WHEN sqlc.arg('set_name')::boolean THEN sqlc.arg('name')::text ELSE name END,
+    tel = CASE WHEN sqlc.arg('set_tel')::boolean THEN sqlc.narg('tel')::text ELSE tel END,
+    -- 번호가 바뀌면 인증 해제
+    tel_verified_at = CASE WHEN sqlc.arg('set_tel')::boolean AND tel IS DISTINCT FROM sqlc.narg('tel')::text THEN NULL ELSE tel_verified_at END,
+    address = CASE WHEN sqlc.arg('set_address')::boolean THEN sqlc.narg('address')::text ELSE address END,
+    updated_at = now()
+WHERE member_id = sqlc.arg('member_id')
+  AND status = 'ACTIVE'
+RETURNING
+    member_id,
+    email,
+    password,
+    name,
+    tel,
+    address,
+    profile,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    password_changed_at,
+    tel_verified_at;
+
+
+-- name: ReplaceMemberProfileImage :one
+UPDATE members AS m
+SET
+    profile = sqlc.narg('profile')::text,
+    updated_at = now()
+FROM (
+    SELECT member_id, profile AS old_profile
+    FROM members
+    WHERE member_id = sqlc.arg('member_id')
+      AND status = 'ACTIVE'
+    FOR UPDATE
+) AS old
+WHERE m.member_id = old.member_id
+RETURNING old.old_profile;
+
+
+-- name: VerifyMemberTel :one
+UPDATE members
+SET
+    tel = @tel,
+    tel_verified_at = now(),
+    updated_at = now()
+WHERE member_id = @member_id
+  AND status = 'ACTIVE'
+RETURNING
+    member_id,
+    email,
+    password,
+    name,
+    tel,
+    address,
+    profile,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    password_changed_at,
+    tel_verified_at;
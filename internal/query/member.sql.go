@@ -8,8 +8,9 @@ package query
 import (
 	"context"
 
-	"study/internal/feature/member"
 	"study/internal/shared/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createMember = `-- name: CreateMember :one
@@ -43,6 +44,22 @@ func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (int
 	return member_id, err
 }
 
+const deleteMemberRole = `-- name: DeleteMemberRole :exec
+DELETE FROM member_roles
+WHERE member_id = $1
+  AND role = $2
+`
+
+type DeleteMemberRoleParams struct {
+	MemberID int64
+	Role     model.Role
+}
+
+func (q *Queries) DeleteMemberRole(ctx context.Context, arg DeleteMemberRoleParams) error {
+	_, err := q.db.Exec(ctx, deleteMemberRole, arg.MemberID, arg.Role)
+	return err
+}
+
 const findMemberByEmail = `-- name: FindMemberByEmail :one
 SELECT
     member_id,
@@ -55,9 +72,12 @@ SELECT
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    password_changed_at,
+    tel_verified_at
 FROM members
 WHERE email = $1
+  AND deleted_at IS NULL
 `
 
 func (q *Queries) FindMemberByEmail(ctx context.Context, email string) (Member, error) {
@@ -75,6 +95,8 @@ func (q *Queries) FindMemberByEmail(ctx context.Context, email string) (Member,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PasswordChangedAt,
+		&i.TelVerifiedAt,
 	)
 	return i, err
 }
@@ -91,7 +113,9 @@ SELECT
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    password_changed_at,
+    tel_verified_at
 FROM members
 WHERE member_id = $1
 `
@@ -111,6 +135,8 @@ func (q *Queries) FindMemberByID(ctx context.Context, memberID int64) (Member, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PasswordChangedAt,
+		&i.TelVerifiedAt,
 	)
 	return i, err
 }
@@ -121,15 +147,15 @@ FROM member_roles
 WHERE member_id = $1
 `
 
-func (q *Queries) GetRolesByMemberID(ctx context.Context, memberID int64) ([]member.Role, error) {
+func (q *Queries) GetRolesByMemberID(ctx context.Context, memberID int64) ([]model.Role, error) {
 	rows, err := q.db.Query(ctx, getRolesByMemberID, memberID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []member.Role
+	var items []model.Role
 	for rows.Next() {
-		var role member.Role
+		var role model.Role
 		if err := rows.Scan(&role); err != nil {
 			return nil, err
 		}
@@ -141,6 +167,29 @@ func (q *Queries) GetRolesByMemberID(ctx context.Context, memberID int64) ([]mem
 	return items, nil
 }
 
+const hasMemberRole = `-- name: HasMemberRole :one
+SELECT EXISTS (
+    SELECT 1
+    FROM member_roles r
+    JOIN members m ON m.member_id = r.member_id
+    WHERE r.member_id = $1
+      AND r.role = $2
+      AND m.status = 'ACTIVE'
+)
+`
+
+type HasMemberRoleParams struct {
+	MemberID int64
+	Role     model.Role
+}
+
+func (q *Queries) HasMemberRole(ctx context.Context, arg HasMemberRoleParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasMemberRole, arg.MemberID, arg.Role)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const insertMemberRole = `-- name: InsertMemberRole :exec
 INSERT INTO member_roles (
     member_id,
@@ -148,14 +197,193 @@ INSERT INTO member_roles (
 ) VALUES (
     $1, $2
 )
+ON CONFLICT (member_id, role) DO NOTHING
 `
 
 type InsertMemberRoleParams struct {
 	MemberID int64
-	Role     member.Role
+	Role     model.Role
 }
 
 func (q *Queries) InsertMemberRole(ctx context.Context, arg InsertMemberRoleParams) error {
 	_, err := q.db.Exec(ctx, insertMemberRole, arg.MemberID, arg.Role)
 	return err
 }
+
+const replaceMemberProfileImage = `-- name: ReplaceMemberProfileImage :one
+UPDATE members AS m
+SET
+    profile = $1::text,
+    updated_at = now()
+FROM (
+    SELECT member_id, profile AS old_profile
+    FROM members
+    WHERE member_id = $2
+      AND status = 'ACTIVE'
+    FOR UPDATE
+) AS old
+WHERE m.member_id = old.member_id
+RETURNING old.old_profile
+`
+
+type ReplaceMemberProfileImageParams struct {
+	Profile  pgtype.Text
+	MemberID int64
+}
+
+func (q *Queries) ReplaceMemberProfileImage(ctx context.Context, arg ReplaceMemberProfileImageParams) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, replaceMemberProfileImage, arg.Profile, arg.MemberID)
+	var old_profile pgtype.Text
+	err := row.Scan(&old_profile)
+	return old_profile, err
+}
+
+const softDeleteMember = `-- name: SoftDeleteMember :exec
+UPDATE members
+SET
+    status = 'DELETED',
+    deleted_at = now(),
+    updated_at = now()
+WHERE member_id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteMember(ctx context.Context, memberID int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMember, memberID)
+	return err
+}
+
+const updateMemberPassword = `-- name: UpdateMemberPassword :exec
+UPDATE members
+SET
+    password = $2,
+    password_changed_at = now(),
+    updated_at = now()
+WHERE member_id = $1
+`
+
+type UpdateMemberPasswordParams struct {
+	MemberID int64
+	Password string
+}
+
+func (q *Queries) UpdateMemberPassword(ctx context.Context, arg UpdateMemberPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateMemberPassword, arg.MemberID, arg.Password)
+	return err
+}
+
+const updateMemberProfile = `-- name: UpdateMemberProfile :one
+UPDATE members
+SET
+    name = CASE WHEN $1::boolean THEN $2::text ELSE name END,
+    tel = CASE WHEN $3::boolean THEN $4::text ELSE tel END,
+    -- 번호가 바뀌면 인증 해제
+    tel_verified_at = CASE WHEN $3::boolean AND tel IS DISTINCT FROM $4::text THEN NULL ELSE tel_verified_at END,
+    address = CASE WHEN $5::boolean THEN $6::text ELSE address END,
+    updated_at = now()
+WHERE member_id = $7
+  AND status = 'ACTIVE'
+RETURNING
+    member_id,
+    email,
+    password,
+    name,
+    tel,
+    address,
+    profile,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    password_changed_at,
+    tel_verified_at
+`
+
+type UpdateMemberProfileParams struct {
+	SetName    bool
+	Name       string
+	SetTel     bool
+	Tel        pgtype.Text
+	SetAddress bool
+	Address    pgtype.Text
+	MemberID   int64
+}
+
+func (q *Queries) UpdateMemberProfile(ctx context.Context, arg UpdateMemberProfileParams) (Member, error) {
+	row := q.db.QueryRow(ctx, updateMemberProfile,
+		arg.SetName,
+		arg.Name,
+		arg.SetTel,
+		arg.Tel,
+		arg.SetAddress,
+		arg.Address,
+		arg.MemberID,
+	)
+	var i Member
+	err := row.Scan(
+		&i.MemberID,
+		&i.Email,
+		&i.Password,
+		&i.Name,
+		&i.Tel,
+		&i.Address,
+		&i.Profile,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.PasswordChangedAt,
+		&i.TelVerifiedAt,
+	)
+	return i, err
+}
+
+const verifyMemberTel = `-- name: VerifyMemberTel :one
+UPDATE members
+SET
+    tel = $1,
+    tel_verified_at = now(),
+    updated_at = now()
+WHERE member_id = $2
+  AND status = 'ACTIVE'
+RETURNING
+    member_id,
+    email,
+    password,
+    name,
+    tel,
+    address,
+    profile,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    password_changed_at,
+    tel_verified_at
+`
+
+type VerifyMemberTelParams struct {
+	Tel      pgtype.Text
+	MemberID int64
+}
+
+func (q *Queries) VerifyMemberTel(ctx context.Context, arg VerifyMemberTelParams) (Member, error) {
+	row := q.db.QueryRow(ctx, verifyMemberTel, arg.Tel, arg.MemberID)
+	var i Member
+	err := row.Scan(
+		&i.MemberID,
+		&i.Email,
+		&i.Password,
+		&i.Name,
+		&i.Tel,
+		&i.Address,
+		&i.Profile,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.PasswordChangedAt,
+		&i.TelVerifiedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,20 @@
+-- name: InsertMemberAuditLog :exec
+INSERT INTO member_audit_logs (
+    actor_id,
+    member_id,
+    action,
+    detail
+) VALUES (
+    $1, $2, $3, $4
+);
+
+
+-- name: CopyMemberAuditLogs :copyfrom
+INSERT INTO member_audit_logs (
+    actor_id,
+    member_id,
+    action,
+    detail
+) VALUES (
+    $1, $2, $3, $4
+);
@@ -0,0 +1,82 @@
+package query
+
+import (
+	"context"
+
+	"study/internal/shared/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// sqlc 로 표현할 수 없는 스트리밍 조회 (:many 는 전체 결과를 메모리에 적재)
+// - 회원 내보내기는 수만 건이라 한 행씩 콜백으로 전달
+
+const exportMembers = `
+SELECT
+    m.member_id,
+    m.email,
+    m.name,
+    m.tel,
+    m.tel_verified_at,
+    m.address,
+    m.status,
+    COALESCE(
+        (SELECT array_agg(r.role ORDER BY r.role) FROM member_roles r WHERE r.member_id = m.member_id),
+        '{}'
+    )::text[] AS roles,
+    m.created_at,
+    m.updated_at
+FROM members m
+WHERE m.deleted_at IS NULL
+  AND (COALESCE(cardinality($1::text[]), 0) = 0 OR m.status = ANY($1::text[]))
+ORDER BY m.member_id
+`
+
+type ExportMembersParams struct {
+	Statuses []string // 비어 있으면 삭제되지 않은 전체 회원
+}
+
+type ExportMembersRow struct {
+	MemberID      int64
+	Email         string
+	Name          string
+	Tel           pgtype.Text
+	TelVerifiedAt pgtype.Timestamp
+	Address       pgtype.Text
+	Status        model.Status
+	Roles         []string
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+}
+
+// member_id 순으로 한 행씩 fn 호출 (fn 이 에러를 반환하면 중단)
+func (q *Queries) ExportMembers(ctx context.Context, arg ExportMembersParams, fn func(ExportMembersRow) error) error {
+	rows, err := q.db.Query(ctx, exportMembers, arg.Statuses)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var i ExportMembersRow
+		if err := rows.Scan(
+			&i.MemberID,
+			&i.Email,
+			&i.Name,
+			&i.Tel,
+			&i.TelVerifiedAt,
+			&i.Address,
+			&i.Status,
+			&i.Roles,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
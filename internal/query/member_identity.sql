@@ -0,0 +1,15 @@
+-- name: CreateMemberIdentity :exec
+INSERT INTO member_identities (
+    provider,
+    subject,
+    member_id
+) VALUES (
+    $1, $2, $3
+);
+
+
+-- name: FindMemberIdentity :one
+SELECT member_id
+FROM member_identities
+WHERE provider = $1
+  AND subject = $2;
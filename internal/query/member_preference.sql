@@ -0,0 +1,22 @@
+-- name: FindMemberPreferences :one
+SELECT
+    member_id,
+    preferences,
+    updated_at
+FROM member_preferences
+WHERE member_id = $1;
+
+
+-- name: UpsertMemberPreferences :one
+-- 변경 값은 병합, 초기화 키는 삭제 (DB 에서 병합해 동시 수정 시 유실 없음)
+INSERT INTO member_preferences (
+    member_id,
+    preferences
+) VALUES (
+    @member_id,
+    @set_values::jsonb - @unset_keys::text[]
+)
+ON CONFLICT (member_id) DO UPDATE
+SET preferences = (member_preferences.preferences || EXCLUDED.preferences) - @unset_keys::text[],
+    updated_at = now()
+RETURNING member_id, preferences, updated_at;
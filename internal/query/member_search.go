@@ -0,0 +1,161 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"study/internal/shared/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// sqlc 로 표현할 수 없는 동적 조건(필터 / 정렬 / 커서) 쿼리
+// - WHERE / ORDER BY 조각은 pagination 패키지가 허용 컬럼과 바인드 파라미터로만 생성
+
+// 검색 조건 바인드 파라미터 시작 번호 ($1~$6 은 검색어 고정 파라미터)
+const SearchMembersArgStart = 7
+
+// 검색 결과 컬럼 (pagination.Field 의 Column)
+const (
+	SearchColumnRank      = "s.rank"
+	SearchColumnMemberID  = "s.member_id"
+	SearchColumnName      = "s.name"
+	SearchColumnEmail     = "s.email"
+	SearchColumnStatus    = "s.status"
+	SearchColumnCreatedAt = "s.created_at"
+)
+
+// 순위
+// - 부분 일치 0.5 + trigram 유사도(이름 / 이메일 단어) + 한글 2-gram 일치 비율 0.5 + 전문 검색 순위
+// 후보
+// - 부분 일치, trigram 유사(pg_trgm.similarity_threshold), 2-gram 절반 이상 일치, 전문 검색 접두 일치
+// - 조건마다 인덱스를 타도록 UNION 으로 후보 member_id 를 모은 뒤 순위 계산 (OR 한 번이면 seq scan)
+// - 이메일 조건은 $6(이메일 검색) 일 때만, 아니면 이름 전용 전문 검색 인덱스 사용
+const searchMembers = `
+WITH candidates AS (
+    SELECT member_id FROM members WHERE name ILIKE $2::text
+    UNION
+    SELECT member_id FROM members WHERE $6::boolean AND email ILIKE $2::text
+    UNION
+    SELECT member_id FROM members WHERE name % $1::text
+    UNION
+    SELECT member_id FROM members WHERE $6::boolean AND email % $1::text
+    UNION
+    SELECT m.member_id
+    FROM unnest($3::text[]) AS gram
+    JOIN members m ON m.name ILIKE gram
+    GROUP BY m.member_id
+    HAVING count(*)::float8 >= 0.5::float8 * cardinality($3::text[])::float8
+    UNION
+    SELECT member_id FROM members
+    WHERE $6::boolean AND $4::text <> ''
+      AND to_tsvector('simple', name || ' ' || translate(email, '@.-_+', '     ')) @@ to_tsquery('simple', $4::text)
+    UNION
+    SELECT member_id FROM members
+    WHERE NOT $6::boolean AND $4::text <> ''
+      AND to_tsvector('simple', name) @@ to_tsquery('simple', $4::text)
+)
+SELECT
+    s.member_id,
+    s.email,
+    s.name,
+    s.status,
+    s.created_at,
+    s.rank
+FROM (
+    SELECT
+        m.member_id,
+        m.email,
+        m.name,
+        m.status,
+        m.created_at,
+        (
+            CASE WHEN m.name ILIKE $2::text OR ($6::boolean AND m.email ILIKE $2::text) THEN 0.5::float8 ELSE 0::float8 END
+            + GREATEST(similarity(m.name, $1::text), CASE WHEN $6::boolean THEN word_similarity($1::text, m.email) ELSE 0 END)::float8
+            + 0.5::float8 * ng.ratio
+            + CASE WHEN $4::text = '' THEN 0::float8
+                   WHEN $6::boolean THEN ts_rank(to_tsvector('simple', m.name || ' ' || translate(m.email, '@.-_+', '     ')), to_tsquery('simple', $4::text))::float8
+                   ELSE ts_rank(to_tsvector('simple', m.name), to_tsquery('simple', $4::text))::float8
+              END
+        ) AS rank
+    FROM candidates c
+    JOIN members m ON m.member_id = c.member_id
+    CROSS JOIN LATERAL (
+        SELECT CASE WHEN COALESCE(cardinality($3::text[]), 0) = 0 THEN 0::float8
+                    ELSE (count(*) FILTER (WHERE m.name ILIKE gram))::float8 / cardinality($3::text[])::float8
+               END AS ratio
+        FROM unnest($3::text[]) AS gram
+    ) ng
+    WHERE m.deleted_at IS NULL
+      AND (NOT $5::boolean OR m.status = 'ACTIVE')
+) s
+WHERE {{where}}
+ORDER BY {{orderBy}}
+LIMIT {{limit}}
+`
+
+type SearchMembersParams struct {
+	Query      string   // 정규화된 검색어
+	Pattern    string   // 부분 일치 패턴
+	Ngrams     []string // 한글 2-gram 패턴
+	TsQuery    string   // 전문 검색 tsquery (없으면 빈 값)
+	ActiveOnly bool     // 활성 회원만
+	MatchEmail bool     // 이메일도 검색 (관리자 검색만)
+
+	Where   string // 추가 조건 (SearchMembersArgStart 번째 인자부터)
+	Args    []any
+	OrderBy string
+	Limit   int
+}
+
+type SearchMembersRow struct {
+	MemberID  int64
+	Email     string
+	Name      string
+	Status    model.Status
+	CreatedAt pgtype.Timestamp
+	Rank      float64
+}
+
+func (q *Queries) SearchMembers(ctx context.Context, arg SearchMembersParams) ([]SearchMembersRow, error) {
+	// pg_trgm 의 % 연산자가 있어 Sprintf 대신 치환
+	sql := strings.NewReplacer(
+		"{{where}}", arg.Where,
+		"{{orderBy}}", arg.OrderBy,
+		"{{limit}}", strconv.Itoa(arg.Limit),
+	).Replace(searchMembers)
+	args := append([]any{
+		arg.Query,
+		arg.Pattern,
+		arg.Ngrams,
+		arg.TsQuery,
+		arg.ActiveOnly,
+		arg.MatchEmail,
+	}, arg.Args...)
+
+	rows, err := q.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMembersRow
+	for rows.Next() {
+		var i SearchMembersRow
+		if err := rows.Scan(
+			&i.MemberID,
+			&i.Email,
+			&i.Name,
+			&i.Status,
+			&i.CreatedAt,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
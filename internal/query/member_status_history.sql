@@ -0,0 +1,37 @@
+-- name: FindMemberStatusForUpdate :one
+-- 상태 전이 동시 요청 방지 (행 잠금)
+SELECT status
+FROM members
+WHERE member_id = $1
+FOR UPDATE;
+
+
+-- name: InsertMemberStatusHistory :exec
+INSERT INTO member_status_history (
+    member_id,
+    from_status,
+    to_status,
+    reason,
+    note,
+    actor_type,
+    actor_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+
+-- name: ListMemberStatusHistory :many
+SELECT
+    member_status_history_id,
+    member_id,
+    from_status,
+    to_status,
+    reason,
+    note,
+    actor_type,
+    actor_id,
+    created_at
+FROM member_status_history
+WHERE member_id = $1
+ORDER BY created_at DESC, member_status_history_id DESC
+LIMIT $2;
@@ -0,0 +1,128 @@
+-- name: ReserveMemberIDs :many
+-- 일괄 가져오기용 member_id 선점 (COPY 는 RETURNING 불가)
+SELECT nextval(pg_get_serial_sequence('members', 'member_id'))::bigint AS member_id
+FROM generate_series(1, @count::int);
+
+
+-- name: FindExistingMemberEmails :many
+SELECT lower(email)::text AS email
+FROM members
+WHERE lower(email) = ANY(@emails::text[])
+  AND deleted_at IS NULL;
+
+
+-- name: CopyMembers :copyfrom
+INSERT INTO members (
+    member_id,
+    email,
+    password,
+    name,
+    tel,
+    address,
+    status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+
+-- name: CopyMemberRoles :copyfrom
+INSERT INTO member_roles (
+    member_id,
+    role
+) VALUES (
+    $1, $2
+);
+
+
+-- name: CopyPasswordHistories :copyfrom
+INSERT INTO member_password_histories (
+    member_id,
+    password
+) VALUES (
+    $1, $2
+);
+
+
+-- name: CreateMemberTransferJob :one
+INSERT INTO member_transfer_jobs (
+    kind,
+    format,
+    status,
+    dry_run,
+    created_by
+) VALUES (
+    @kind,
+    @format,
+    'PENDING',
+    @dry_run,
+    @created_by
+)
+RETURNING job_id, kind, format, status, dry_run, total_rows, succeeded_rows, failed_rows, report, result_key, error, created_by, created_at, started_at, finished_at;
+
+
+-- name: FindMemberTransferJob :one
+SELECT
+    job_id,
+    kind,
+    format,
+    status,
+    dry_run,
+    total_rows,
+    succeeded_rows,
+    failed_rows,
+    report,
+    result_key,
+    error,
+    created_by,
+    created_at,
+    started_at,
+    finished_at
+FROM member_transfer_jobs
+WHERE job_id = $1;
+
+
+-- name: StartMemberTransferJob :exec
+UPDATE member_transfer_jobs
+SET status = 'RUNNING',
+    started_at = now()
+WHERE job_id = $1;
+
+
+-- name: FinishMemberTransferJob :exec
+UPDATE member_transfer_jobs
+SET status = @status,
+    total_rows = @total_rows,
+    succeeded_rows = @succeeded_rows,
+    failed_rows = @failed_rows,
+    report = @report,
+    result_key = @result_key,
+    error = @error,
+    finished_at = now()
+WHERE job_id = @job_id;
+
+
+-- name: FailStaleMemberTransferJobs :execrows
+-- 제한 시간이 지나도 끝나지 않은 작업 (서버 재시작 등으로 중단) 실패 처리
+UPDATE member_transfer_jobs
+SET status = 'FAILED',
+    error = @error,
+    finished_at = now()
+WHERE status IN ('PENDING', 'RUNNING')
+  AND COALESCE(started_at, created_at) < now() - make_interval(mins => @timeout_min::int);
+
+
+-- name: ListExpiredMemberTransferResults :many
+-- 보관 기간이 지난 결과 파일
+SELECT job_id, result_key::text AS result_key
+FROM member_transfer_jobs
+WHERE result_key IS NOT NULL
+  AND finished_at < now() - make_interval(hours => @retention_hours::int)
+ORDER BY job_id
+LIMIT @limit_count;
+
+
+-- name: ClearMemberTransferResult :exec
+UPDATE member_transfer_jobs
+SET result_key = NULL
+WHERE job_id = $1;
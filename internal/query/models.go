@@ -6,26 +6,155 @@ package query
 
 import (
 	"github.com/jackc/pgx/v5/pgtype"
-	"study/internal/feature/member"
 	"study/internal/shared/model"
 )
 
+type AccessToken struct {
+	TokenHash string
+	MemberID  pgtype.Int8
+	Claims    []byte
+	ExpiresAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+}
+
 type Member struct {
+	MemberID          int64
+	Email             string
+	Password          string
+	Name              string
+	Tel               pgtype.Text
+	Address           pgtype.Text
+	Profile           pgtype.Text
+	Status            model.Status
+	CreatedAt         pgtype.Timestamp
+	UpdatedAt         pgtype.Timestamp
+	DeletedAt         pgtype.Timestamp
+	PasswordChangedAt pgtype.Timestamp
+	TelVerifiedAt     pgtype.Timestamp
+}
+
+type MemberAuditLog struct {
+	AuditLogID int64
+	ActorID    int64
+	MemberID   int64
+	Action     string
+	Detail     string
+	CreatedAt  pgtype.Timestamp
+}
+
+type MemberIdentity struct {
+	Provider  string
+	Subject   string
 	MemberID  int64
-	Email     string
-	Password  string
-	Name      string
-	Tel       pgtype.Text
-	Address   pgtype.Text
-	Profile   pgtype.Text
-	Status    model.Status
 	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+}
+
+type MemberPasswordHistory struct {
+	MemberPasswordHistoryID int64
+	MemberID                int64
+	Password                string
+	CreatedAt               pgtype.Timestamp
+}
+
+type MemberPreference struct {
+	MemberID    int64
+	Preferences []byte
+	UpdatedAt   pgtype.Timestamp
 }
 
 type MemberRole struct {
 	MemberRoleID int64
 	MemberID     int64
-	Role         member.Role
+	Role         model.Role
+}
+
+type MemberStatusHistory struct {
+	MemberStatusHistoryID int64
+	MemberID              int64
+	FromStatus            model.Status
+	ToStatus              model.Status
+	Reason                string
+	Note                  pgtype.Text
+	ActorType             string
+	ActorID               pgtype.Text
+	CreatedAt             pgtype.Timestamp
+}
+
+type MemberTransferJob struct {
+	JobID         int64
+	Kind          string
+	Format        string
+	Status        string
+	DryRun        bool
+	TotalRows     int32
+	SucceededRows int32
+	FailedRows    int32
+	Report        []byte
+	ResultKey     pgtype.Text
+	Error         pgtype.Text
+	CreatedBy     int64
+	CreatedAt     pgtype.Timestamp
+	StartedAt     pgtype.Timestamp
+	FinishedAt    pgtype.Timestamp
+}
+
+type OauthAuthorizationCode struct {
+	CodeHash      string
+	ClientID      string
+	MemberID      int64
+	RedirectUri   string
+	Scope         string
+	Nonce         string
+	CodeChallenge string
+	ExpiresAt     pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+	UsedAt        pgtype.Timestamp
+}
+
+type OauthClient struct {
+	ClientID     string
+	ClientSecret pgtype.Text
+	Name         string
+	RedirectUris []string
+	GrantTypes   []string
+	Scopes       []string
+	CreatedAt    pgtype.Timestamp
+	RevokedAt    pgtype.Timestamp
+}
+
+type OauthConsent struct {
+	MemberID  int64
+	ClientID  string
+	Scope     string
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type PhoneVerification struct {
+	VerificationID int64
+	MemberID       int64
+	Tel            string
+	CodeHash       string
+	Attempts       int32
+	ExpiresAt      pgtype.Timestamp
+	VerifiedAt     pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+}
+
+type RefreshSession struct {
+	SessionID     string
+	MemberID      int64
+	IdleExpiresAt pgtype.Timestamp
+	ExpiresAt     pgtype.Timestamp
+	LastUsedAt    pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+	RevokedAt     pgtype.Timestamp
+}
+
+type ScimMember struct {
+	MemberID   int64
+	TenantID   string
+	ExternalID pgtype.Text
+	CreatedAt  pgtype.Timestamp
 }
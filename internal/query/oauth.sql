@@ -0,0 +1,76 @@
+-- name: FindOAuthClient :one
+SELECT
+    client_id,
+    client_secret,
+    name,
+    redirect_uris,
+    grant_types,
+    scopes,
+    created_at,
+    revoked_at
+FROM oauth_clients
+WHERE client_id = $1
+  AND revoked_at IS NULL;
+
+
+-- name: CreateAuthorizationCode :exec
+INSERT INTO oauth_authorization_codes (
+    code_hash,
+    client_id,
+    member_id,
+    redirect_uri,
+    scope,
+    nonce,
+    code_challenge,
+    expires_at
+) VALUES (
+    @code_hash,
+    @client_id,
+    @member_id,
+    @redirect_uri,
+    @scope,
+    @nonce,
+    @code_challenge,
+    now() + make_interval(secs => @expire_sec::int)
+);
+
+
+-- name: ConsumeAuthorizationCode :one
+UPDATE oauth_authorization_codes
+SET used_at = now()
+WHERE code_hash = $1
+  AND used_at IS NULL
+  AND expires_at > now()
+RETURNING
+    client_id,
+    member_id,
+    redirect_uri,
+    scope,
+    nonce,
+    code_challenge;
+
+
+-- name: DeleteExpiredAuthorizationCodes :exec
+DELETE FROM oauth_authorization_codes
+WHERE expires_at < now();
+
+
+-- name: FindOAuthConsentScope :one
+SELECT scope
+FROM oauth_consents
+WHERE member_id = $1
+  AND client_id = $2;
+
+
+-- name: UpsertOAuthConsent :exec
+INSERT INTO oauth_consents (
+    member_id,
+    client_id,
+    scope
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (member_id, client_id)
+DO UPDATE SET
+    scope = EXCLUDED.scope,
+    updated_at = now();
@@ -0,0 +1,27 @@
+-- name: InsertPasswordHistory :exec
+INSERT INTO member_password_histories (
+    member_id,
+    password
+) VALUES (
+    $1, $2
+);
+
+
+-- name: GetRecentPasswordHistories :many
+SELECT password
+FROM member_password_histories
+WHERE member_id = $1
+ORDER BY created_at DESC, member_password_history_id DESC
+LIMIT $2;
+
+
+-- name: DeleteOldPasswordHistories :exec
+DELETE FROM member_password_histories
+WHERE member_id = $1
+  AND member_password_history_id NOT IN (
+    SELECT h.member_password_history_id
+    FROM member_password_histories h
+    WHERE h.member_id = $1
+    ORDER BY h.created_at DESC, h.member_password_history_id DESC
+    LIMIT $2
+  );
@@ -0,0 +1,81 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// 가입 승인 대기 목록 (READY 회원)
+// - WHERE / ORDER BY 조각은 pagination 패키지가 허용 컬럼과 바인드 파라미터로만 생성
+
+// 대기 목록 조건 바인드 파라미터 시작 번호 (고정 파라미터 없음)
+const ListPendingSignupsArgStart = 1
+
+// 대기 목록 컬럼 (pagination.Field 의 Column)
+const (
+	PendingColumnMemberID  = "m.member_id"
+	PendingColumnEmail     = "m.email"
+	PendingColumnCreatedAt = "m.created_at"
+)
+
+const listPendingSignups = `
+SELECT
+    m.member_id,
+    m.email,
+    m.name,
+    m.created_at
+FROM members m
+WHERE m.status = 'READY'
+  AND m.deleted_at IS NULL
+  AND {{where}}
+ORDER BY {{orderBy}}
+LIMIT {{limit}}
+`
+
+type ListPendingSignupsParams struct {
+	Where   string // 추가 조건 ($1 부터)
+	Args    []any
+	OrderBy string
+	Limit   int
+}
+
+type ListPendingSignupsRow struct {
+	MemberID  int64
+	Email     string
+	Name      string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) ListPendingSignups(ctx context.Context, arg ListPendingSignupsParams) ([]ListPendingSignupsRow, error) {
+	sql := strings.NewReplacer(
+		"{{where}}", arg.Where,
+		"{{orderBy}}", arg.OrderBy,
+		"{{limit}}", strconv.Itoa(arg.Limit),
+	).Replace(listPendingSignups)
+
+	rows, err := q.db.Query(ctx, sql, arg.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPendingSignupsRow
+	for rows.Next() {
+		var i ListPendingSignupsRow
+		if err := rows.Scan(
+			&i.MemberID,
+			&i.Email,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
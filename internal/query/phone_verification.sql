@@ -0,0 +1,60 @@
+-- name: CreatePhoneVerification :one
+INSERT INTO phone_verifications (
+    member_id,
+    tel,
+    code_hash,
+    expires_at
+) VALUES (
+    @member_id,
+    @tel,
+    @code_hash,
+    now() + make_interval(mins => @expire_min::int)
+)
+RETURNING verification_id, expires_at;
+
+
+-- name: CountPhoneVerificationsSince :one
+SELECT count(*)
+FROM phone_verifications
+WHERE member_id = @member_id
+  AND created_at > now() - make_interval(secs => @window_sec::int);
+
+
+-- name: ExpirePhoneVerifications :exec
+UPDATE phone_verifications
+SET expires_at = now()
+WHERE member_id = $1
+  AND verified_at IS NULL
+  AND expires_at > now();
+
+
+-- name: FindActivePhoneVerification :one
+SELECT
+    verification_id,
+    member_id,
+    tel,
+    code_hash,
+    attempts,
+    expires_at,
+    verified_at,
+    created_at
+FROM phone_verifications
+WHERE member_id = $1
+  AND verified_at IS NULL
+  AND expires_at > now()
+ORDER BY created_at DESC, verification_id DESC
+LIMIT 1
+FOR UPDATE;
+
+
+-- name: IncrementPhoneVerificationAttempts :one
+UPDATE phone_verifications
+SET attempts = attempts + 1
+WHERE verification_id = $1
+RETURNING attempts;
+
+
+-- name: MarkPhoneVerificationVerified :exec
+UPDATE phone_verifications
+SET verified_at = now()
+WHERE verification_id = $1;
@@ -0,0 +1,84 @@
+-- name: CreateScimMember :exec
+INSERT INTO scim_members (
+    member_id,
+    tenant_id,
+    external_id
+) VALUES (
+    $1, $2, $3
+);
+
+
+-- name: FindScimMember :one
+SELECT
+    m.member_id,
+    m.email,
+    m.name,
+    m.status,
+    m.created_at,
+    m.updated_at,
+    s.external_id
+FROM scim_members s
+JOIN members m ON m.member_id = s.member_id
+WHERE s.tenant_id = $1
+  AND s.member_id = $2
+  AND m.deleted_at IS NULL;
+
+
+-- name: ListScimMembers :many
+SELECT
+    m.member_id,
+    m.email,
+    m.name,
+    m.status,
+    m.created_at,
+    m.updated_at,
+    s.external_id
+FROM scim_members s
+JOIN members m ON m.member_id = s.member_id
+WHERE s.tenant_id = @tenant_id
+  AND m.deleted_at IS NULL
+  AND (sqlc.narg('user_name')::text IS NULL OR lower(m.email) = lower(sqlc.narg('user_name')::text))
+  AND (sqlc.narg('external_id')::text IS NULL OR s.external_id = sqlc.narg('external_id')::text)
+  AND (sqlc.narg('active')::boolean IS NULL OR (m.status = 'ACTIVE') = sqlc.narg('active')::boolean)
+ORDER BY m.member_id
+LIMIT @limit_count
+OFFSET @offset_count;
+
+
+-- name: CountScimMembers :one
+SELECT count(*)
+FROM scim_members s
+JOIN members m ON m.member_id = s.member_id
+WHERE s.tenant_id = @tenant_id
+  AND m.deleted_at IS NULL
+  AND (sqlc.narg('user_name')::text IS NULL OR lower(m.email) = lower(sqlc.narg('user_name')::text))
+  AND (sqlc.narg('external_id')::text IS NULL OR s.external_id = sqlc.narg('external_id')::text)
+  AND (sqlc.narg('active')::boolean IS NULL OR (m.status = 'ACTIVE') = sqlc.narg('active')::boolean);
+
+
+-- name: UpdateScimMember :exec
+UPDATE members
+SET
+    email = $2,
+    name = $3,
+    updated_at = now()
+WHERE member_id = $1;
+
+
+-- name: UpdateScimExternalID :exec
+UPDATE scim_members
+SET external_id = $2
+WHERE member_id = $1;
+
+
+-- name: ListScimMembersByRole :many
+SELECT
+    m.member_id,
+    m.email
+FROM member_roles r
+JOIN scim_members s ON s.member_id = r.member_id
+JOIN members m ON m.member_id = r.member_id
+WHERE s.tenant_id = $1
+  AND r.role = $2
+  AND m.deleted_at IS NULL
+ORDER BY m.member_id;
@@ -0,0 +1,46 @@
+-- name: CreateRefreshSession :exec
+INSERT INTO refresh_sessions (
+    session_id,
+    member_id,
+    idle_expires_at,
+    expires_at
+) VALUES (
+    @session_id,
+    @member_id,
+    now() + make_interval(mins => @idle_min::int),
+    now() + make_interval(days => @expire_day::int)
+);
+
+
+-- name: TouchRefreshSession :execrows
+UPDATE refresh_sessions
+SET
+    last_used_at = now(),
+    idle_expires_at = LEAST(now() + make_interval(mins => @idle_min::int), expires_at)
+WHERE session_id = @session_id
+  AND member_id = @member_id
+  AND revoked_at IS NULL
+  AND idle_expires_at > now()
+  AND expires_at > now();
+
+
+-- name: RevokeRefreshSession :exec
+UPDATE refresh_sessions
+SET revoked_at = now()
+WHERE session_id = $1
+  AND revoked_at IS NULL;
+
+
+-- name: RevokeRefreshSessionsByMemberID :exec
+UPDATE refresh_sessions
+SET revoked_at = now()
+WHERE member_id = $1
+  AND revoked_at IS NULL;
+
+
+-- name: DeleteExpiredRefreshSessions :execrows
+-- 폐기, 유휴 만료, 절대 만료된 세션 삭제
+DELETE FROM refresh_sessions
+WHERE revoked_at IS NOT NULL
+   OR idle_expires_at < now()
+   OR expires_at < now();
@@ -1,28 +1,143 @@
 package router
 
 import (
+	"context"
+
+	"study/internal/config"
+	"study/internal/feature/admin"
+	"study/internal/feature/approval"
 	"study/internal/feature/auth"
+	"study/internal/feature/lifecycle"
+	"study/internal/feature/member"
+	"study/internal/feature/oauth"
+	"study/internal/feature/preference"
+	"study/internal/feature/scim"
+	"study/internal/feature/transfer"
+	"study/internal/mail"
 	"study/internal/middleware"
 	"study/internal/query"
+	"study/internal/shared/model"
+	"study/internal/shared/profileimage"
+	"study/internal/sms"
+	"study/internal/storage"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func Register(app *fiber.App, pool *pgxpool.Pool, queries *query.Queries, jwtService *auth.JwtService, cookieService *auth.CookieService, authMiddleware *middleware.AuthMiddlewareConfig) {
+func Register(app *fiber.App, cfg *config.Config, pool *pgxpool.Pool, queries *query.Queries, store storage.Storage, smsSender sms.Sender, mailSender mail.Sender, jwtService *auth.JwtService, cookieService *auth.CookieService, dpopVerifier *auth.DPoPVerifier, idTokenSigner *auth.IDTokenSigner, authMiddleware *middleware.AuthMiddlewareConfig) {
+	// 요청 본문 제한 (회원 가져오기 파일만 스트리밍, 크기는 핸들러에서 memberTransfer.maxUploadMB 로 제한)
+	app.Use(middleware.BodyLimit(cfg.Storage.BodyLimit(), fiber.MethodPost+" /api/v1/admin/members/import"))
+
 	api := app.Group("/api")
 	v1 := api.Group("/v1")
 
+	// 프로필 이미지 저장 경로 → 서명 URL (auth / admin / userinfo 응답)
+	profileResolver := profileimage.NewResolver(store, &cfg.Storage)
+
 	// auth
-	authService := auth.NewAuthService(pool, queries, jwtService)
-	authHandler := auth.NewAuthHandler(authService, cookieService)
+	sessionService := auth.NewSessionService(queries, &cfg.JWT)
+	// 폐기 / 만료된 리프레쉬 세션 삭제
+	go sessionService.RunPurge(context.Background())
+	authenticators := auth.NewAuthenticatorChainFromConfig(&cfg.Authenticator, queries)
+	// 인증 훅 (기능별 가입 / 로그인 규칙, 토큰 클레임 추가는 여기서 등록)
+	authHooks := auth.NewHookRegistry()
+	authService := auth.NewAuthService(pool, queries, jwtService, sessionService, authenticators, authHooks, &cfg.Password, &cfg.Signup, profileResolver)
+	authHandler := auth.NewAuthHandler(authService, cookieService, dpopVerifier)
 	authRouter := auth.NewAuthRouter(authHandler)
 
+	// lifecycle (회원 상태 전이, 상태별 처리는 훅으로 등록)
+	lifecycleService := lifecycle.NewLifecycleService(queries)
+	lifecycleService.OnLeave(model.StatusActive, "revoke_sessions", lifecycle.RevokeSessions)
+
+	// member
+	memberService := member.NewMemberService(pool, queries, store, smsSender, &cfg.Storage, &cfg.PhoneVerification)
+	memberHandler := member.NewMemberHandler(memberService)
+	memberRouter := member.NewMemberRouter(memberHandler)
+
+	// preference (회원 설정, 기능별 설정은 preferenceRegistry 에 Register)
+	preferenceRegistry := preference.NewDefaultRegistry()
+	preferenceService := preference.NewPreferenceService(queries, preferenceRegistry)
+	preferenceHandler := preference.NewPreferenceHandler(preferenceService)
+	preferenceRouter := preference.NewPreferenceRouter(preferenceHandler)
+
+	// admin (회원 관리)
+	adminService := admin.NewAdminService(pool, queries, lifecycleService, profileResolver, preferenceService)
+	adminHandler := admin.NewAdminHandler(adminService)
+	adminRouter := admin.NewAdminRouter(adminHandler)
+
+	// approval (가입 승인 대기열, signup.mode: approval)
+	approvalService := approval.NewApprovalService(pool, queries, lifecycleService, mailSender)
+	approvalHandler := approval.NewApprovalHandler(approvalService)
+	approvalRouter := approval.NewApprovalRouter(approvalHandler)
+
+	// transfer (회원 일괄 가져오기 / 내보내기)
+	transferService := transfer.NewTransferService(pool, queries, store, &cfg.MemberTransfer, &cfg.Storage)
+	// 중단된 작업 실패 처리, 보관 기간이 지난 결과 파일 삭제
+	go transferService.RunCleanup(context.Background())
+	transferHandler := transfer.NewTransferHandler(transferService)
+	transferRouter := transfer.NewTransferRouter(transferHandler)
+
+	// oauth (OpenID Connect Provider)
+	oauthService := oauth.NewOAuthService(queries, jwtService, sessionService, idTokenSigner, &cfg.OIDC, profileResolver)
+	oauthHandler := oauth.NewOAuthHandler(oauthService, cookieService)
+	oauthRouter := oauth.NewOAuthRouter(oauthHandler)
+
+	// scim (IdP 프로비저닝)
+	scimService := scim.NewScimService(pool, queries, lifecycleService, &cfg.Scim)
+	scimHandler := scim.NewScimHandler(scimService)
+	scimRouter := scim.NewScimRouter(scimHandler)
+
+	// ==================================== OIDC Discovery
+	oauthRouter.RegisterWellKnownRoutes(app)
+
 	// ==================================== 인증 필요 없음
 	authRouter.RegisterRoutes(v1)
+	oauthRouter.RegisterRoutes(v1)
+
+	// ==================================== 서명 URL 다운로드 (local 저장소)
+	if local, ok := store.(*storage.LocalStorage); ok {
+		app.Get(storage.LocalPath+"/*", local.Handler())
+	}
+
+	// ==================================== 리소스 서버 인증 (토큰 인트로스펙션)
+	v1Introspection := v1.Group("/auth/introspect", middleware.IntrospectionAuth(&cfg.Introspection))
+	authRouter.RegisterIntrospectionRoutes(v1Introspection)
+
+	// ==================================== SCIM 테넌트 인증
+	scimV2 := app.Group("/scim/v2", middleware.ScimAuth(&cfg.Scim))
+	scimRouter.RegisterRoutes(scimV2)
+
+	// ==================================== OAuth 클라이언트 토큰 (userinfo 전용)
+	v1UserInfo := v1.Group("/oauth/userinfo", authMiddleware.AuthMiddleware(jwtService, middleware.AllowClientTokens()))
+	oauthRouter.RegisterUserInfoRoutes(v1UserInfo)
+
+	// ==================================== 선택 인증 (익명 / 로그인 공용)
+	v1Status := v1.Group("/auth/status", authMiddleware.AuthMiddleware(jwtService, middleware.OptionalAuth()))
+	authRouter.RegisterStatusRoutes(v1Status)
+
+	// ==================================== 인증 필요 (비밀번호 만료 허용, 최근 재인증 필요)
+	v1Password := v1.Group("/auth/password", authMiddleware.AuthMiddleware(jwtService, middleware.AllowPasswordExpired()), authMiddleware.RequireRecentAuth(jwtService))
+	authRouter.RegisterPasswordRoutes(v1Password)
 
 	// ==================================== 인증 필요
-	v1Auth := v1.Group("", authMiddleware.AuthMiddleware(jwtService))
+	var authOptions []middleware.AuthOption
+	if cfg.JWT.RenewExpired {
+		authOptions = append(authOptions, middleware.RenewExpiredAccess(authService))
+	}
+	v1Auth := v1.Group("", authMiddleware.AuthMiddleware(jwtService, authOptions...))
 	authRouter.RegisterAuthRoutes(v1Auth)
+	memberRouter.RegisterAuthRoutes(v1Auth)
+	preferenceRouter.RegisterAuthRoutes(v1Auth)
+
+	// ==================================== 최근 재인증 필요 (세션 관리)
+	v1Sessions := v1Auth.Group("/auth/sessions", authMiddleware.RequireRecentAuth(jwtService))
+	authRouter.RegisterSessionRoutes(v1Sessions)
+
+	// ==================================== 관리자 권한 필요 (최근 재인증 필요)
+	v1Admin := v1Auth.Group("/admin", middleware.RequireRole(queries, model.RoleAdmin), authMiddleware.RequireRecentAuth(jwtService))
+	adminRouter.RegisterRoutes(v1Admin)
+	approvalRouter.RegisterRoutes(v1Admin)
+	transferRouter.RegisterRoutes(v1Admin)
 
 }
@@ -9,4 +9,13 @@ var (
 
 	// 필수값 누락
 	ErrRequiredFieldMissing = errors.New("REQUIRED_FIELD_MISSING")
+
+	// 요청 본문 크기 초과
+	ErrBodyTooLarge = errors.New("BODY_TOO_LARGE")
+
+	// 권한 없음
+	ErrForbidden = errors.New("FORBIDDEN")
+
+	// 내부 처리 실패
+	ErrInternal = errors.New("INTERNAL_ERROR")
 )
@@ -1,4 +1,4 @@
-package member
+package model
 
 type Role string
 
@@ -6,3 +6,6 @@ const (
 	RoleUser  Role = "USER"
 	RoleAdmin Role = "ADMIN"
 )
+
+// 전체 권한 목록
+var Roles = []Role{RoleUser, RoleAdmin}
@@ -0,0 +1,110 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// 커서 본문 (클라이언트에는 불투명한 base64url 문자열)
+// - S: 발급 당시 정렬 조건 (다른 정렬로 재사용 방지)
+// - V: 마지막 행의 정렬 키 값
+type cursor struct {
+	S string `json:"s"`
+	V []any  `json:"v"`
+}
+
+// 정렬 조건 식별자 (예: -createdAt,id)
+func sortSignature(keys []SortKey) string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.Name
+		if key.Desc {
+			names[i] = "-" + key.Name
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// 정렬 키 값 → 커서
+func encodeCursor(keys []SortKey, values []any) (string, error) {
+	encoded := make([]any, len(values))
+	for i, v := range values {
+		// 시간은 나노초까지 보존 (keyset 비교가 정확히 맞아야 함)
+		if t, ok := v.(time.Time); ok {
+			v = t.UTC().Format(time.RFC3339Nano)
+		}
+		encoded[i] = v
+	}
+
+	b, err := json.Marshal(cursor{S: sortSignature(keys), V: encoded})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// 커서 → 정렬 키 값 (정렬 조건, 값 개수 / 타입 확인)
+func decodeCursor(s string, keys []SortKey) ([]any, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c cursor
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.S != sortSignature(keys) || len(c.V) != len(keys) {
+		return nil, ErrInvalidCursor
+	}
+
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		v, err := cursorValue(key.Field.Type, c.V[i])
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func cursorValue(t Type, v any) (any, error) {
+	switch t {
+	case TypeInt:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return n.Int64()
+	case TypeTime:
+		s, ok := v.(string)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case TypeFloat:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return n.Float64()
+	case TypeBool:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return b, nil
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return s, nil
+	}
+}
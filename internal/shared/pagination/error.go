@@ -0,0 +1,18 @@
+package pagination
+
+import "errors"
+
+// 목록 조회 파라미터 에러
+var (
+	// limit 범위 오류
+	ErrInvalidLimit = errors.New("INVALID_LIMIT")
+
+	// 허용하지 않는 정렬 키 / 형식 오류
+	ErrInvalidSort = errors.New("INVALID_SORT")
+
+	// 허용하지 않는 필터 연산자 / 값 형식 오류
+	ErrInvalidFilter = errors.New("INVALID_FILTER")
+
+	// 손상되었거나 다른 정렬 조건으로 발급된 커서
+	ErrInvalidCursor = errors.New("INVALID_CURSOR")
+)
@@ -0,0 +1,39 @@
+package pagination
+
+// 목록 응답 (response.Response 의 data)
+type Page[T any] struct {
+	Items      []T     `json:"items"`
+	NextCursor *string `json:"nextCursor"`
+	HasMore    bool    `json:"hasMore"`
+}
+
+// 조회 결과 → 페이지
+// - rows 는 FetchLimit() 만큼 조회한 결과 (Limit 초과분으로 다음 페이지 판단)
+// - key 는 행의 정렬 키 값 (Request.Sort 의 Name 기준)
+func NewPage[T any](req *Request, rows []T, key func(row T, name string) any) (*Page[T], error) {
+	page := &Page[T]{Items: rows}
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+
+	if len(rows) <= req.Limit {
+		return page, nil
+	}
+
+	page.Items = rows[:req.Limit]
+	page.HasMore = true
+
+	last := page.Items[len(page.Items)-1]
+	values := make([]any, len(req.Sort))
+	for i, sortKey := range req.Sort {
+		values[i] = key(last, sortKey.Name)
+	}
+
+	next, err := encodeCursor(req.Sort, values)
+	if err != nil {
+		return nil, err
+	}
+	page.NextCursor = &next
+
+	return page, nil
+}
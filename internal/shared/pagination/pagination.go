@@ -0,0 +1,260 @@
+package pagination
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 예약 파라미터 (필터로 해석하지 않음)
+const (
+	ParamCursor = "cursor"
+	ParamLimit  = "limit"
+	ParamSort   = "sort"
+)
+
+const (
+	// 정렬 키 최대 개수 (유일 키 제외)
+	maxSortKeys = 3
+
+	// in 연산자 최대 값 개수
+	maxInValues = 50
+)
+
+// 컬럼 값 타입 (쿼리 파라미터 / 커서 값 변환 기준)
+type Type int
+
+const (
+	TypeString Type = iota
+	TypeInt
+	TypeTime // RFC3339 또는 2006-01-02
+	TypeBool
+	TypeFloat // 검색 순위 등 계산 값 (float8)
+)
+
+// 필터 연산자 (name=value 는 eq, name[op]=value)
+type Op string
+
+const (
+	OpEq       Op = "eq"
+	OpNe       Op = "ne"
+	OpGt       Op = "gt"
+	OpGte      Op = "gte"
+	OpLt       Op = "lt"
+	OpLte      Op = "lte"
+	OpIn       Op = "in"       // 쉼표 구분 목록
+	OpContains Op = "contains" // 부분 일치 (대소문자 무시, 문자열만)
+)
+
+// 정렬 / 필터 가능한 컬럼
+// - Column 은 SQL 에 그대로 들어가므로 코드에 고정된 값만 사용 (요청 값 금지)
+// - 정렬 컬럼은 NOT NULL 이어야 커서 비교가 성립
+type Field struct {
+	Column string
+	Type   Type
+}
+
+// 필터 가능한 컬럼과 허용 연산자 (비어있으면 eq 만)
+type Filter struct {
+	Field
+	Ops []Op
+}
+
+// 목록 API 별 허용 파라미터
+type Spec struct {
+	DefaultLimit int
+	MaxLimit     int
+
+	// 정렬 키 → 컬럼 (sort=name,-createdAt)
+	Sorts map[string]Field
+
+	// 기본 정렬 (예: -createdAt)
+	DefaultSort string
+
+	// 유일 키 이름 (Sorts 에 포함, 정렬 마지막에 항상 추가해 순서를 고정)
+	Key string
+
+	// 필터 키 → 컬럼 / 허용 연산자
+	Filters map[string]Filter
+}
+
+// 정렬 키
+type SortKey struct {
+	Name  string
+	Field Field
+	Desc  bool
+}
+
+// 필터 조건
+type Condition struct {
+	Name   string
+	Field  Field
+	Op     Op
+	Values []any
+}
+
+// 검증된 목록 조회 요청
+type Request struct {
+	Limit      int
+	Sort       []SortKey
+	Conditions []Condition
+
+	// 이전 페이지 마지막 행의 정렬 키 값 (첫 페이지는 nil)
+	after []any
+}
+
+// 쿼리 파라미터 → 목록 조회 요청
+// - 정의되지 않은 파라미터는 무시 (API 별 추가 파라미터 허용)
+func Parse(spec *Spec, params map[string]string) (*Request, error) {
+	req := &Request{}
+
+	limit, err := parseLimit(spec, params[ParamLimit])
+	if err != nil {
+		return nil, err
+	}
+	req.Limit = limit
+
+	if req.Sort, err = parseSort(spec, params[ParamSort]); err != nil {
+		return nil, err
+	}
+
+	if req.Conditions, err = parseFilters(spec, params); err != nil {
+		return nil, err
+	}
+
+	if cursor := params[ParamCursor]; cursor != "" {
+		if req.after, err = decodeCursor(cursor, req.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+func parseLimit(spec *Spec, value string) (int, error) {
+	if value == "" {
+		return spec.DefaultLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > spec.MaxLimit {
+		return 0, ErrInvalidLimit
+	}
+	return limit, nil
+}
+
+// sort=-createdAt,name (앞에 - 는 내림차순), 유일 키를 마지막에 추가
+func parseSort(spec *Spec, value string) ([]SortKey, error) {
+	if value == "" {
+		value = spec.DefaultSort
+	}
+
+	names := strings.Split(value, ",")
+	if len(names) > maxSortKeys {
+		return nil, ErrInvalidSort
+	}
+
+	keys := make([]SortKey, 0, len(names)+1)
+	seen := map[string]bool{}
+	for _, name := range names {
+		desc := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+
+		field, ok := spec.Sorts[name]
+		if !ok || seen[name] {
+			return nil, ErrInvalidSort
+		}
+		seen[name] = true
+		keys = append(keys, SortKey{Name: name, Field: field, Desc: desc})
+	}
+
+	// 유일 키는 마지막 정렬 키와 같은 방향
+	if !seen[spec.Key] {
+		keys = append(keys, SortKey{Name: spec.Key, Field: spec.Sorts[spec.Key], Desc: keys[len(keys)-1].Desc})
+	}
+
+	return keys, nil
+}
+
+// name=value, name[op]=value (조건 순서는 키 이름순으로 고정)
+func parseFilters(spec *Spec, params map[string]string) ([]Condition, error) {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var conditions []Condition
+	for _, key := range keys {
+		name, op := key, OpEq
+		if i := strings.IndexByte(key, '['); i > 0 && strings.HasSuffix(key, "]") {
+			name, op = key[:i], Op(key[i+1:len(key)-1])
+		}
+
+		filter, ok := spec.Filters[name]
+		if !ok {
+			continue
+		}
+		if !filterAllows(filter, op) {
+			return nil, ErrInvalidFilter
+		}
+
+		values, err := parseFilterValues(filter.Field, op, params[key])
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, Condition{Name: name, Field: filter.Field, Op: op, Values: values})
+	}
+
+	return conditions, nil
+}
+
+func filterAllows(filter Filter, op Op) bool {
+	if len(filter.Ops) == 0 {
+		return op == OpEq
+	}
+	if op == OpContains && filter.Type != TypeString {
+		return false
+	}
+	return slices.Contains(filter.Ops, op)
+}
+
+func parseFilterValues(field Field, op Op, value string) ([]any, error) {
+	raw := []string{value}
+	if op == OpIn {
+		raw = strings.Split(value, ",")
+		if len(raw) > maxInValues {
+			return nil, ErrInvalidFilter
+		}
+	}
+
+	values := make([]any, 0, len(raw))
+	for _, s := range raw {
+		v, err := parseValue(field.Type, strings.TrimSpace(s))
+		if err != nil || (field.Type == TypeString && v == "") {
+			return nil, ErrInvalidFilter
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// 문자열 → 컬럼 타입 값
+func parseValue(t Type, s string) (any, error) {
+	switch t {
+	case TypeInt:
+		return strconv.ParseInt(s, 10, 64)
+	case TypeTime:
+		if d, err := time.Parse(time.DateOnly, s); err == nil {
+			return d, nil
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case TypeBool:
+		return strconv.ParseBool(s)
+	case TypeFloat:
+		return strconv.ParseFloat(s, 64)
+	default:
+		return s, nil
+	}
+}
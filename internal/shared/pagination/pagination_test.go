@@ -0,0 +1,181 @@
+package pagination
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testSpec = &Spec{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	Sorts: map[string]Field{
+		"id":        {Column: "m.member_id", Type: TypeInt},
+		"name":      {Column: "m.name", Type: TypeString},
+		"createdAt": {Column: "m.created_at", Type: TypeTime},
+	},
+	DefaultSort: "-createdAt",
+	Key:         "id",
+	Filters: map[string]Filter{
+		"status":    {Field: Field{Column: "m.status", Type: TypeString}, Ops: []Op{OpEq, OpIn}},
+		"name":      {Field: Field{Column: "m.name", Type: TypeString}, Ops: []Op{OpContains}},
+		"createdAt": {Field: Field{Column: "m.created_at", Type: TypeTime}, Ops: []Op{OpGte, OpLt}},
+		"id":        {Field: Field{Column: "m.member_id", Type: TypeInt}},
+	},
+}
+
+type row struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+func rowKey(r row, name string) any {
+	if name == "createdAt" {
+		return r.CreatedAt
+	}
+	return r.ID
+}
+
+func TestParseDefaults(t *testing.T) {
+	req, err := Parse(testSpec, map[string]string{"q": "무시"})
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+
+	if req.Limit != 20 {
+		t.Errorf("기본 limit 오류: %d", req.Limit)
+	}
+	if got := req.OrderBy(); got != "m.created_at DESC, m.member_id DESC" {
+		t.Errorf("기본 정렬 오류: %s", got)
+	}
+	if where, args := req.Where(1); where != "TRUE" || args != nil {
+		t.Errorf("조건 없음 오류: %s %v", where, args)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		params map[string]string
+		err    error
+	}{
+		{"limit 0", map[string]string{"limit": "0"}, ErrInvalidLimit},
+		{"limit 초과", map[string]string{"limit": "101"}, ErrInvalidLimit},
+		{"limit 문자", map[string]string{"limit": "ten"}, ErrInvalidLimit},
+		{"정렬 키 없음", map[string]string{"sort": "password"}, ErrInvalidSort},
+		{"정렬 키 중복", map[string]string{"sort": "name,-name"}, ErrInvalidSort},
+		{"정렬 SQL 주입", map[string]string{"sort": "name;DROP TABLE members"}, ErrInvalidSort},
+		{"허용되지 않은 연산자", map[string]string{"status[contains]": "ACT"}, ErrInvalidFilter},
+		{"연산자 미지정 필드 eq 외", map[string]string{"id[gt]": "1"}, ErrInvalidFilter},
+		{"숫자 형식 오류", map[string]string{"id": "abc"}, ErrInvalidFilter},
+		{"날짜 형식 오류", map[string]string{"createdAt[gte]": "yesterday"}, ErrInvalidFilter},
+		{"빈 값", map[string]string{"status": ""}, ErrInvalidFilter},
+		{"커서 형식 오류", map[string]string{"cursor": "!!"}, ErrInvalidCursor},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Parse(testSpec, tc.params); err != tc.err {
+				t.Errorf("결과 불일치: %v, 기대: %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestWhereFilters(t *testing.T) {
+	req, err := Parse(testSpec, map[string]string{
+		"status[in]":     "ACTIVE,DISABLED",
+		"name[contains]": "50%_off",
+		"createdAt[gte]": "2025-01-01",
+		"sort":           "name",
+	})
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+
+	where, args := req.Where(3)
+	want := "m.created_at >= $3 AND m.name ILIKE $4 AND m.status = ANY($5)"
+	if where != want {
+		t.Errorf("조건 오류\n got: %s\nwant: %s", where, want)
+	}
+
+	wantArgs := []any{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		`%50\%\_off%`,
+		[]string{"ACTIVE", "DISABLED"},
+	}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("바인드 값 오류: %#v", args)
+	}
+	if got := req.OrderBy(); got != "m.name ASC, m.member_id ASC" {
+		t.Errorf("정렬 오류: %s", got)
+	}
+}
+
+func TestCursorPaging(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	rows := []row{
+		{ID: 5, CreatedAt: base.Add(3 * time.Hour)},
+		{ID: 4, CreatedAt: base.Add(2 * time.Hour)},
+		{ID: 3, CreatedAt: base.Add(time.Hour)},
+	}
+
+	req, err := Parse(testSpec, map[string]string{"limit": "2"})
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+
+	page, err := NewPage(req, rows, rowKey)
+	if err != nil {
+		t.Fatalf("페이지 생성 실패: %v", err)
+	}
+	if len(page.Items) != 2 || !page.HasMore || page.NextCursor == nil {
+		t.Fatalf("다음 페이지 판단 오류: %+v", page)
+	}
+
+	// 같은 정렬로 다음 페이지 (같은 방향이면 행 비교)
+	next, err := Parse(testSpec, map[string]string{"limit": "2", "cursor": *page.NextCursor})
+	if err != nil {
+		t.Fatalf("커서 파싱 실패: %v", err)
+	}
+	where, args := next.Where(1)
+	if where != "(m.created_at, m.member_id) < ($1, $2)" {
+		t.Errorf("커서 조건 오류: %s", where)
+	}
+	if !reflect.DeepEqual(args, []any{base.Add(2 * time.Hour), int64(4)}) {
+		t.Errorf("커서 값 오류 (나노초 보존): %#v", args)
+	}
+
+	// 다른 정렬로 재사용 불가
+	if _, err := Parse(testSpec, map[string]string{"sort": "createdAt", "cursor": *page.NextCursor}); err != ErrInvalidCursor {
+		t.Errorf("다른 정렬 커서는 거부되어야 함: %v", err)
+	}
+
+	// 마지막 페이지
+	last, err := NewPage(next, rows[2:], rowKey)
+	if err != nil || last.HasMore || last.NextCursor != nil {
+		t.Errorf("마지막 페이지 판단 오류: %+v %v", last, err)
+	}
+}
+
+func TestKeysetMixedDirection(t *testing.T) {
+	req, err := Parse(testSpec, map[string]string{"sort": "name,-createdAt"})
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+	cursor, err := encodeCursor(req.Sort, []any{"kim", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), int64(7)})
+	if err != nil {
+		t.Fatalf("커서 생성 실패: %v", err)
+	}
+
+	req, err = Parse(testSpec, map[string]string{"sort": "name,-createdAt", "cursor": cursor})
+	if err != nil {
+		t.Fatalf("커서 파싱 실패: %v", err)
+	}
+
+	where, _ := req.Where(1)
+	want := "((m.name > $1) OR (m.name = $1 AND m.created_at < $2) OR (m.name = $1 AND m.created_at = $2 AND m.member_id < $3))"
+	if where != want {
+		t.Errorf("혼합 방향 조건 오류\n got: %s\nwant: %s", where, want)
+	}
+}
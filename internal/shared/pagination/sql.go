@@ -0,0 +1,156 @@
+package pagination
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LIKE 특수문자 이스케이프 (부분 일치 검색어)
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// 비교 연산자
+var comparisons = map[Op]string{
+	OpEq:  "=",
+	OpNe:  "<>",
+	OpGt:  ">",
+	OpGte: ">=",
+	OpLt:  "<",
+	OpLte: "<=",
+}
+
+// WHERE 조건 (필터 + 커서 이후 행)
+// - argStart: 첫 바인드 번호 (고정 조건이 $1..$n 을 쓰면 n+1)
+// - 값은 모두 바인드 파라미터, 컬럼은 Spec 에 고정된 값만 사용
+// - 조건이 없으면 "TRUE"
+func (r *Request) Where(argStart int) (string, []any) {
+	b := &sqlBuilder{next: argStart}
+
+	var clauses []string
+	for _, c := range r.Conditions {
+		clauses = append(clauses, b.condition(c))
+	}
+	if r.after != nil {
+		clauses = append(clauses, b.keyset(r.Sort, r.after))
+	}
+
+	if len(clauses) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(clauses, " AND "), b.args
+}
+
+// ORDER BY 절 (예: m.created_at DESC, m.member_id DESC)
+func (r *Request) OrderBy() string {
+	parts := make([]string, len(r.Sort))
+	for i, key := range r.Sort {
+		parts[i] = key.Field.Column + direction(key.Desc)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// 조회 개수 (다음 페이지 존재 여부 확인용 1건 추가)
+func (r *Request) FetchLimit() int {
+	return r.Limit + 1
+}
+
+type sqlBuilder struct {
+	next int
+	args []any
+}
+
+// 바인드 파라미터 추가 → $N
+func (b *sqlBuilder) bind(v any) string {
+	b.args = append(b.args, v)
+	placeholder := "$" + strconv.Itoa(b.next)
+	b.next++
+	return placeholder
+}
+
+func (b *sqlBuilder) condition(c Condition) string {
+	switch c.Op {
+	case OpIn:
+		return c.Field.Column + " = ANY(" + b.bind(typedSlice(c.Field.Type, c.Values)) + ")"
+	case OpContains:
+		return c.Field.Column + " ILIKE " + b.bind("%"+likeEscaper.Replace(c.Values[0].(string))+"%")
+	default:
+		return c.Field.Column + " " + comparisons[c.Op] + " " + b.bind(c.Values[0])
+	}
+}
+
+// 커서 이후 행 조건
+// - 정렬 방향이 모두 같으면 행 비교 (a, b) > ($1, $2) 로 인덱스 사용
+// - 섞여 있으면 (a > $1) OR (a = $1 AND b < $2) ... 로 펼침
+func (b *sqlBuilder) keyset(keys []SortKey, values []any) string {
+	sameDirection := true
+	for _, key := range keys[1:] {
+		if key.Desc != keys[0].Desc {
+			sameDirection = false
+		}
+	}
+
+	if sameDirection {
+		columns := make([]string, len(keys))
+		placeholders := make([]string, len(keys))
+		for i, key := range keys {
+			columns[i] = key.Field.Column
+			placeholders[i] = b.bind(values[i])
+		}
+		return "(" + strings.Join(columns, ", ") + ") " + after(keys[0].Desc) + " (" + strings.Join(placeholders, ", ") + ")"
+	}
+
+	placeholders := make([]string, len(keys))
+	for i := range keys {
+		placeholders[i] = b.bind(values[i])
+	}
+
+	ors := make([]string, len(keys))
+	for i, key := range keys {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, keys[j].Field.Column+" = "+placeholders[j])
+		}
+		ands = append(ands, key.Field.Column+" "+after(key.Desc)+" "+placeholders[i])
+		ors[i] = "(" + strings.Join(ands, " AND ") + ")"
+	}
+	return "(" + strings.Join(ors, " OR ") + ")"
+}
+
+// 정렬 방향 기준 "다음" 비교 연산자
+func after(desc bool) string {
+	if desc {
+		return "<"
+	}
+	return ">"
+}
+
+func direction(desc bool) string {
+	if desc {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+// in 값 목록 → pgx 배열 파라미터
+func typedSlice(t Type, values []any) any {
+	switch t {
+	case TypeInt:
+		return convertSlice[int64](values)
+	case TypeTime:
+		return convertSlice[time.Time](values)
+	case TypeBool:
+		return convertSlice[bool](values)
+	case TypeFloat:
+		return convertSlice[float64](values)
+	default:
+		return convertSlice[string](values)
+	}
+}
+
+func convertSlice[T any](values []any) []T {
+	out := make([]T, len(values))
+	for i, v := range values {
+		out[i] = v.(T)
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package profileimage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"study/internal/config"
+	"study/internal/storage"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// 프로필 이미지 (member 업로드, auth / admin 응답 공용)
+// - 저장 경로: profiles/{memberID}/{random}/{size}.jpg
+// - members.profile 에는 {size}.jpg 앞까지의 경로(prefix)만 저장
+
+// 저장 경로 시작
+const KeyPrefix = "profiles/"
+
+// 표준 썸네일 (정사각형, 한 변 픽셀)
+type Size struct {
+	Name string
+	Side int
+}
+
+var Sizes = []Size{
+	{Name: "small", Side: 64},
+	{Name: "medium", Side: 256},
+	{Name: "large", Side: 512},
+}
+
+// 프로필 이미지 응답 DTO (기간 제한 다운로드 URL)
+type Response struct {
+	Small     string    `json:"small"`
+	Medium    string    `json:"medium"`
+	Large     string    `json:"large"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// {prefix}/{size}.jpg
+func Key(prefix string, size Size) string {
+	return prefix + "/" + size.Name + ".jpg"
+}
+
+// profile 컬럼 → 썸네일 키 목록
+// - 본인 경로(profiles/{memberID}/...)가 아니면 업로드 API 로 올린 이미지가 아니므로 무시
+func Keys(memberID int64, profile pgtype.Text) []string {
+	if !profile.Valid || !strings.HasPrefix(profile.String, fmt.Sprintf("%s%d/", KeyPrefix, memberID)) {
+		return nil
+	}
+
+	keys := make([]string, 0, len(Sizes))
+	for _, size := range Sizes {
+		keys = append(keys, Key(profile.String, size))
+	}
+	return keys
+}
+
+// 저장 경로 → 썸네일별 서명 다운로드 URL
+type Resolver struct {
+	storage storage.Storage
+	ttl     time.Duration
+}
+
+// 생성자
+func NewResolver(storage storage.Storage, cfg *config.Storage) *Resolver {
+	return &Resolver{storage: storage, ttl: time.Duration(cfg.URLExpireMin) * time.Minute}
+}
+
+// 프로필 이미지 URL (이미지 없으면 nil)
+func (r *Resolver) Resolve(ctx context.Context, memberID int64, profile pgtype.Text) (*Response, error) {
+	if Keys(memberID, profile) == nil {
+		return nil, nil
+	}
+
+	urls := make(map[string]string, len(Sizes))
+	for _, size := range Sizes {
+		url, err := r.storage.SignedURL(ctx, Key(profile.String, size), r.ttl)
+		if err != nil {
+			return nil, err
+		}
+		urls[size.Name] = url
+	}
+
+	return &Response{
+		Small:     urls["small"],
+		Medium:    urls["medium"],
+		Large:     urls["large"],
+		ExpiresAt: time.Now().Add(r.ttl),
+	}, nil
+}
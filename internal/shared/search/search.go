@@ -0,0 +1,144 @@
+package search
+
+import (
+	"errors"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 검색어 형식 오류 (빈 값, 길이 초과)
+var ErrInvalidQuery = errors.New("INVALID_SEARCH_QUERY")
+
+const (
+	maxQueryLength = 50 // 문자 수
+	maxTerms       = 5
+	maxNgrams      = 20
+)
+
+// LIKE 특수문자 이스케이프
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// 정규화된 검색어
+type Query struct {
+	// 소문자, 공백 정리된 검색어 (trigram 유사도 비교용)
+	Text string
+
+	// 공백 기준 검색어 목록
+	Terms []string
+
+	// 부분 일치 패턴 (%검색어%)
+	Pattern string
+
+	// 한글 2-gram 부분 일치 패턴 (%길동%)
+	// - 한글 이름은 공백으로 나뉘지 않고 2~3 음절이라 trigram / 형태소 분석이 잘 맞지 않음
+	Ngrams []string
+
+	// 전문 검색 접두 일치 tsquery (simple 사전, 예: hong:* & gildong:*)
+	TsQuery string
+}
+
+// 검색어 정규화 및 토큰 분리
+func Parse(raw string) (*Query, error) {
+	text := strings.Join(strings.Fields(strings.ToLower(raw)), " ")
+	if text == "" || utf8.RuneCountInString(text) > maxQueryLength {
+		return nil, ErrInvalidQuery
+	}
+
+	q := &Query{
+		Text:    text,
+		Terms:   strings.Fields(text),
+		Pattern: "%" + likeEscaper.Replace(text) + "%",
+	}
+	if len(q.Terms) > maxTerms {
+		q.Terms = q.Terms[:maxTerms]
+	}
+
+	var lexemes []string
+	for _, term := range q.Terms {
+		for _, gram := range hangulNgrams(term) {
+			pattern := "%" + likeEscaper.Replace(gram) + "%"
+			if len(q.Ngrams) < maxNgrams && !slices.Contains(q.Ngrams, pattern) {
+				q.Ngrams = append(q.Ngrams, pattern)
+			}
+		}
+
+		// 이메일 구분자 등은 to_tsvector 처럼 공백으로 취급
+		for _, word := range strings.FieldsFunc(term, isSeparator) {
+			lexemes = append(lexemes, word+":*")
+		}
+	}
+	q.TsQuery = strings.Join(lexemes, " & ")
+
+	return q, nil
+}
+
+// 한글이 포함된 검색어의 2-gram (1음절은 그대로)
+func hangulNgrams(term string) []string {
+	runes := []rune(term)
+	if !slices.ContainsFunc(runes, isHangul) {
+		return nil
+	}
+	if len(runes) == 1 {
+		return []string{term}
+	}
+
+	grams := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		grams = append(grams, string(runes[i:i+2]))
+	}
+	return grams
+}
+
+func isHangul(r rune) bool {
+	return unicode.Is(unicode.Hangul, r)
+}
+
+// tsquery 로 안전한 문자만 단어로 사용 (연산자 문자 제거)
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// 검색어와 일치하는 부분 강조 (<em>, HTML 이스케이프)
+// - 검색어 전체, 각 단어, 한글 2-gram 중 일치하는 구간을 병합해 표시
+func Highlight(text string, q *Query) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	needles := append([]string{q.Text}, q.Terms...)
+	for _, term := range q.Terms {
+		needles = append(needles, hangulNgrams(term)...)
+	}
+
+	marked := make([]bool, len(runes))
+	for _, needle := range needles {
+		n := []rune(needle)
+		if len(n) == 0 {
+			continue
+		}
+		for i := 0; i+len(n) <= len(lower); i++ {
+			if slices.Equal(lower[i:i+len(n)], n) {
+				for j := i; j < i+len(n); j++ {
+					marked[j] = true
+				}
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i, r := range runes {
+		if marked[i] && (i == 0 || !marked[i-1]) {
+			b.WriteString("<em>")
+		}
+		b.WriteString(html.EscapeString(string(r)))
+		if marked[i] && (i == len(runes)-1 || !marked[i+1]) {
+			b.WriteString("</em>")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	q, err := Parse("  Hong.GilDong@Example  길동 ")
+	if err != nil {
+		t.Fatalf("파싱 실패: %v", err)
+	}
+
+	if q.Text != "hong.gildong@example 길동" {
+		t.Errorf("정규화 오류: %q", q.Text)
+	}
+	if q.TsQuery != "hong:* & gildong:* & example:* & 길동:*" {
+		t.Errorf("tsquery 오류: %q", q.TsQuery)
+	}
+	if !slices.Equal(q.Ngrams, []string{"%길동%"}) {
+		t.Errorf("n-gram 오류: %v", q.Ngrams)
+	}
+
+	q, _ = Parse("홍길동")
+	if !slices.Equal(q.Ngrams, []string{"%홍길%", "%길동%"}) {
+		t.Errorf("한글 2-gram 오류: %v", q.Ngrams)
+	}
+
+	// LIKE / tsquery 특수문자
+	q, _ = Parse("50%_off & !x")
+	if q.Pattern != `%50\%\_off & !x%` || q.TsQuery != "50:* & off:* & x:*" {
+		t.Errorf("특수문자 처리 오류: %q %q", q.Pattern, q.TsQuery)
+	}
+
+	for _, raw := range []string{"", "   ", string(make([]rune, 51))} {
+		if _, err := Parse(raw); err != ErrInvalidQuery {
+			t.Errorf("%q 는 거부되어야 함: %v", raw, err)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	cases := []struct {
+		query string
+		text  string
+		want  string
+	}{
+		{"길동", "홍길동", "홍<em>길동</em>"},
+		// 오타가 있어도 일치하는 2-gram 은 강조
+		{"홍길똥", "홍길동", "<em>홍길</em>동"},
+		{"hong", "Hong.GilDong@example.com", "<em>Hong</em>.GilDong@example.com"},
+		{"<b>", "<b>name", "<em>&lt;b&gt;</em>name"},
+		{"kim", "lee", "lee"},
+	}
+
+	for _, tc := range cases {
+		q, err := Parse(tc.query)
+		if err != nil {
+			t.Fatalf("파싱 실패: %v", err)
+		}
+		if got := Highlight(tc.text, q); got != tc.want {
+			t.Errorf("Highlight(%q, %q) = %q, 기대: %q", tc.text, tc.query, got, tc.want)
+		}
+	}
+}
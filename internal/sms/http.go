@@ -0,0 +1,59 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTPSender
+// - 발송 대행사 HTTP API 어댑터
+// - POST {url} (Authorization: Bearer {apiKey}, body: Message JSON), 2xx 면 성공
+type HTTPSender struct {
+	from   string
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+func NewHTTPSender(from string, url string, apiKey string, timeout time.Duration) *HTTPSender {
+	return &HTTPSender{
+		from:   from,
+		url:    url,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, to string, text string) error {
+	body, err := json.Marshal(Message{From: s.from, To: to, Text: text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sms 발송 실패: status=%d body=%s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,36 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"study/internal/config"
+)
+
+// 문자 메시지 (발신 번호는 설정의 sender)
+type Message struct {
+	From string `json:"from"`
+	To   string `json:"to"` // E.164 (+821012345678)
+	Text string `json:"text"`
+}
+
+// Sender
+// - SMS 발송 추상화 (개발: console / file, 운영: http 발송 대행사)
+type Sender interface {
+	Send(ctx context.Context, to string, text string) error
+}
+
+// 설정의 provider 에 맞는 발송기 생성
+func New(cfg *config.SMS) (Sender, error) {
+	switch cfg.Provider {
+	case "console":
+		return NewConsoleSender(cfg.Sender), nil
+	case "file":
+		return NewFileSender(cfg.Sender, cfg.File.Path)
+	case "http":
+		return NewHTTPSender(cfg.Sender, cfg.HTTP.URL, cfg.HTTP.APIKey, time.Duration(cfg.HTTP.TimeoutSec)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 sms provider: %q", cfg.Provider)
+	}
+}
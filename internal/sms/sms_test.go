@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms", "sent.jsonl")
+	sender, err := NewFileSender("0212345678", path)
+	if err != nil {
+		t.Fatalf("생성 실패: %v", err)
+	}
+
+	for _, text := range []string{"첫 번째", "두 번째"} {
+		if err := sender.Send(context.Background(), "+821012345678", text); err != nil {
+			t.Fatalf("발송 실패: %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("파일 열기 실패: %v", err)
+	}
+	defer f.Close()
+
+	var texts []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record fileRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("JSONL 파싱 실패: %v", err)
+		}
+		texts = append(texts, record.Text)
+	}
+	if len(texts) != 2 || texts[1] != "두 번째" {
+		t.Fatalf("발송 내역 오류: %v", texts)
+	}
+}
+
+func TestHTTPSender(t *testing.T) {
+	var received Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"invalid api key"}`))
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	if err := NewHTTPSender("0212345678", server.URL, "key", time.Second).Send(context.Background(), "+821012345678", "인증번호"); err != nil {
+		t.Fatalf("발송 실패: %v", err)
+	}
+	if received != (Message{From: "0212345678", To: "+821012345678", Text: "인증번호"}) {
+		t.Fatalf("요청 본문 오류: %+v", received)
+	}
+
+	if err := NewHTTPSender("0212345678", server.URL, "wrong", time.Second).Send(context.Background(), "+821012345678", "인증번호"); err == nil {
+		t.Fatal("4xx 응답은 에러여야 함")
+	}
+}
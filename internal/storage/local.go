@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"study/internal/config"
+	"study/pkg/response"
+	"study/pkg/util"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// local 저장소 다운로드 경로 (서명 URL 검증 후 파일 전송)
+const LocalPath = "/api/v1/files"
+
+// LocalStorage
+// - 로컬 파일시스템 저장소 (개발 / 단일 서버용)
+// - 다운로드 URL 은 HMAC 서명 + 만료 시각으로 보호
+type LocalStorage struct {
+	dir     string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// 생성자 (저장 디렉터리가 없으면 생성)
+func NewLocalStorage(cfg *config.StorageLocal, secret []byte) (*LocalStorage, error) {
+	dir := cfg.Dir
+	if !filepath.IsAbs(dir) {
+		dir = util.GetPath(dir)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		secret:  secret,
+		now:     time.Now,
+	}, nil
+}
+
+// 객체 저장 (임시 파일에 쓴 뒤 rename, 읽는 중인 파일이 깨지지 않도록)
+func (s *LocalStorage) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	return s.PutStream(ctx, key, bytes.NewReader(body), int64(len(body)), contentType)
+}
+
+// 객체 스트리밍 저장 (임시 파일에 복사 후 rename)
+func (s *LocalStorage) PutStream(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// 객체 삭제 (비게 된 상위 디렉터리도 정리)
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := s.path(key)
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// 비어있지 않은 디렉터리는 Remove 가 실패하므로 거기서 멈춤
+	for dir := filepath.Dir(path); dir != s.dir && strings.HasPrefix(dir, s.dir); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// 서명 다운로드 URL 발급
+// - {baseURL}/api/v1/files/{key}?expires={unix}&signature={hmac}
+func (s *LocalStorage) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(s.now().Add(ttl).Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, expires))
+
+	return s.baseURL + LocalPath + "/" + key + "?" + query.Encode(), nil
+}
+
+// 서명 URL 검증
+func (s *LocalStorage) Verify(key string, expires string, signature string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > exp {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// 서명 URL 다운로드 핸들러 (LocalPath + "/*" 에 등록)
+func (s *LocalStorage) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Params("*")
+
+		if err := s.Verify(key, c.Query("expires"), c.Query("signature")); err != nil {
+			return c.Status(fiber.StatusForbidden).JSON(response.Error(ErrInvalidSignature.Error(), "다운로드 URL 이 유효하지 않습니다", nil))
+		}
+
+		path := s.path(key)
+		if _, err := os.Stat(path); err != nil {
+			return c.Status(fiber.StatusNotFound).JSON(response.Error(ErrNotFound.Error(), "파일 없음", nil))
+		}
+
+		// 서명 URL 만료 이후에도 오래 남지 않도록 짧게 캐시
+		c.Set(fiber.HeaderCacheControl, "private, max-age=60")
+		c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+		return c.SendFile(path)
+	}
+}
+
+// HMAC-SHA256(secret, key + "\n" + expires)
+func (s *LocalStorage) sign(key string, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// 키 → 파일 경로
+func (s *LocalStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"study/internal/config"
+)
+
+// S3Storage
+// - S3 호환 저장소 (AWS S3, MinIO 등)
+// - 요청은 SigV4 로 서명, 다운로드는 presigned GET URL
+type S3Storage struct {
+	endpoint  *url.URL
+	bucket    string
+	pathStyle bool
+	signer    *sigV4Signer
+	client    *http.Client
+	now       func() time.Time
+}
+
+// 생성자
+func NewS3Storage(cfg *config.StorageS3) (*S3Storage, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("storage s3 endpoint 가 올바르지 않습니다: %q", cfg.Endpoint)
+	}
+
+	return &S3Storage{
+		endpoint:  endpoint,
+		bucket:    cfg.Bucket,
+		pathStyle: cfg.PathStyle,
+		signer: &sigV4Signer{
+			accessKey: cfg.AccessKey,
+			secretKey: cfg.SecretKey,
+			region:    cfg.Region,
+			service:   "s3",
+		},
+		client: &http.Client{Timeout: 30 * time.Second},
+		now:    time.Now,
+	}, nil
+}
+
+// 객체 저장 (PUT Object)
+func (s *S3Storage) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	return s.do(req, hashHex(body), http.StatusOK)
+}
+
+// 객체 스트리밍 저장 (PUT Object, 본문 해시 대신 UNSIGNED-PAYLOAD 로 서명)
+// - 본문 무결성은 TLS 로 보호 (https endpoint 권장)
+func (s *S3Storage) PutStream(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	return s.do(req, sigV4UnsignedPayload, http.StatusOK)
+}
+
+// 객체 삭제 (DELETE Object, 없는 키도 204)
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	return s.do(req, emptyPayloadHash, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+// presigned GET URL 발급 (S3 최대 7일)
+func (s *S3Storage) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	return s.signer.presign(http.MethodGet, s.objectURL(key), ttl, s.now()), nil
+}
+
+// 객체 URL (path-style: endpoint/bucket/key, virtual-hosted: bucket.endpoint/key)
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.pathStyle {
+		u.Path = u.Path + "/" + s.bucket + "/" + key
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = u.Path + "/" + key
+	}
+	return &u
+}
+
+// 서명 후 요청, 허용 상태 코드 외에는 에러 (S3 에러 본문 일부 포함)
+func (s *S3Storage) do(req *http.Request, payloadHash string, okStatus ...int) error {
+	s.signer.sign(req, payloadHash, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	for _, status := range okStatus {
+		if resp.StatusCode == status {
+			io.Copy(io.Discard, resp.Body)
+			return nil
+		}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("s3 %s %s 실패: status=%d body=%s", req.Method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+}
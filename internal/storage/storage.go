@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"study/internal/config"
+)
+
+// 저장소 에러
+var (
+	// 허용하지 않는 객체 키 (빈 값, 절대 경로, 상위 경로 등)
+	ErrInvalidKey = errors.New("INVALID_STORAGE_KEY")
+
+	// 객체 없음
+	ErrNotFound = errors.New("FILE_NOT_FOUND")
+
+	// 다운로드 서명 URL 검증 실패 (만료, 서명 불일치)
+	ErrInvalidSignature = errors.New("INVALID_STORAGE_SIGNATURE")
+)
+
+// Storage
+// - 오브젝트 저장소 추상화 (local 파일시스템, S3 호환 저장소)
+// - 키는 "/" 로 구분된 상대 경로 (예: profiles/1/abc/small.jpg)
+type Storage interface {
+	// 객체 저장 (같은 키가 있으면 덮어씀)
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+
+	// 객체 스트리밍 저장 (size 바이트를 메모리에 올리지 않고 전송, 큰 파일용)
+	PutStream(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+
+	// 객체 삭제 (없는 키는 성공 처리)
+	Delete(ctx context.Context, key string) error
+
+	// 기간 제한 다운로드 URL 발급
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// 설정의 backend 에 맞는 저장소 생성
+func New(cfg *config.Storage) (Storage, error) {
+	switch cfg.Backend {
+	case "local":
+		return NewLocalStorage(&cfg.Local, cfg.SigningSecret)
+	case "s3":
+		return NewS3Storage(&cfg.S3)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 storage backend: %q", cfg.Backend)
+	}
+}
+
+// 객체 키 검증
+// - 영문/숫자/-/_/. 로 된 경로 세그먼트만 허용 (URL 인코딩, 경로 탈출 방지)
+func validateKey(key string) error {
+	if key == "" || len(key) > 512 {
+		return ErrInvalidKey
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+		for _, r := range segment {
+			if !isKeyChar(r) {
+				return ErrInvalidKey
+			}
+		}
+	}
+
+	return nil
+}
+
+func isKeyChar(r rune) bool {
+	return r >= 'a' && r <= 'z' ||
+		r >= 'A' && r <= 'Z' ||
+		r >= '0' && r <= '9' ||
+		r == '-' || r == '_' || r == '.'
+}
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"study/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestValidateKey(t *testing.T) {
+	valid := []string{"profiles/1/abc/small.jpg", "a", "a-b_c.d/e"}
+	for _, key := range valid {
+		if err := validateKey(key); err != nil {
+			t.Fatalf("%q 는 허용되어야 함: %v", key, err)
+		}
+	}
+
+	invalid := []string{"", "/abs", "a/../b", "a//b", "a/./b", "a/b/", "a\\b", "a b", "한글"}
+	for _, key := range invalid {
+		if err := validateKey(key); err != ErrInvalidKey {
+			t.Fatalf("%q 는 거부되어야 함: %v", key, err)
+		}
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store, err := NewLocalStorage(&config.StorageLocal{Dir: dir, BaseURL: "http://localhost:3000/"}, []byte("secret"))
+	if err != nil {
+		t.Fatalf("생성 실패: %v", err)
+	}
+	store.now = func() time.Time { return now }
+
+	const key = "profiles/1/abc/small.jpg"
+	if err := store.Put(ctx, key, []byte("image"), "image/jpeg"); err != nil {
+		t.Fatalf("저장 실패: %v", err)
+	}
+
+	signed, err := store.SignedURL(ctx, key, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("서명 URL 발급 실패: %v", err)
+	}
+	if !strings.HasPrefix(signed, "http://localhost:3000"+LocalPath+"/"+key+"?") {
+		t.Fatalf("서명 URL 형식 오류: %s", signed)
+	}
+
+	app := fiber.New()
+	app.Get(LocalPath+"/*", store.Handler())
+
+	get := func(target string) *http.Response {
+		t.Helper()
+		u, _ := url.Parse(target)
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+		if err != nil {
+			t.Fatalf("요청 실패: %v", err)
+		}
+		return resp
+	}
+
+	// 정상 다운로드
+	resp := get(signed)
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != "image" {
+		t.Fatalf("다운로드 실패: status=%d body=%s", resp.StatusCode, body)
+	}
+
+	// 서명 변조 / 다른 키
+	if resp := get(strings.Replace(signed, "small.jpg", "large.jpg", 1)); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("다른 키는 403 이어야 함: %d", resp.StatusCode)
+	}
+
+	// 만료
+	now = now.Add(11 * time.Minute)
+	if resp := get(signed); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("만료 URL 은 403 이어야 함: %d", resp.StatusCode)
+	}
+
+	// 삭제 시 비게 된 디렉터리까지 정리, 재삭제는 성공
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("삭제 실패: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "profiles")); !os.IsNotExist(err) {
+		t.Fatalf("빈 디렉터리가 남아있음: %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("없는 키 삭제는 성공해야 함: %v", err)
+	}
+}
+
+// MinIO 대용 S3 서버 (path-style, 서명 헤더 확인)
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), sigV4Algorithm+" Credential=access/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, "<Error><Code>AccessDenied</Code></Error>")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		if hash := r.Header.Get("X-Amz-Content-Sha256"); hash != sigV4UnsignedPayload && hash != hashHex(body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := NewS3Storage(&config.StorageS3{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "study",
+		PathStyle: true,
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("생성 실패: %v", err)
+	}
+
+	const key = "profiles/1/abc/small.jpg"
+	if err := store.Put(ctx, key, []byte("image"), "image/jpeg"); err != nil {
+		t.Fatalf("저장 실패: %v", err)
+	}
+	if string(fake.objects["/study/"+key]) != "image" {
+		t.Fatalf("path-style 경로로 저장되지 않음: %v", fake.objects)
+	}
+
+	signed, err := store.SignedURL(ctx, key, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("서명 URL 발급 실패: %v", err)
+	}
+	u, _ := url.Parse(signed)
+	if u.Path != "/study/"+key || u.Query().Get("X-Amz-Expires") != "600" || u.Query().Get("X-Amz-Signature") == "" {
+		t.Fatalf("presigned URL 형식 오류: %s", signed)
+	}
+
+	// 스트리밍 저장 (UNSIGNED-PAYLOAD)
+	const exportKey = "transfer/1/members.csv"
+	if err := store.PutStream(ctx, exportKey, strings.NewReader("email,name\n"), 11, "text/csv"); err != nil {
+		t.Fatalf("스트리밍 저장 실패: %v", err)
+	}
+	if string(fake.objects["/study/"+exportKey]) != "email,name\n" {
+		t.Fatalf("스트리밍 저장 내용 불일치: %q", fake.objects["/study/"+exportKey])
+	}
+
+	for _, k := range []string{key, exportKey} {
+		if err := store.Delete(ctx, k); err != nil {
+			t.Fatalf("삭제 실패: %v", err)
+		}
+	}
+	if len(fake.objects) != 0 {
+		t.Fatalf("삭제되지 않음: %v", fake.objects)
+	}
+
+	// 서명 거부 응답은 에러
+	store.signer.accessKey = "wrong"
+	if err := store.Put(ctx, key, []byte("image"), "image/jpeg"); err == nil || !strings.Contains(err.Error(), "AccessDenied") {
+		t.Fatalf("거부 응답은 에러여야 함: %v", err)
+	}
+}
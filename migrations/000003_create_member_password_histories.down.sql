@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS member_password_histories;
+
+ALTER TABLE members
+DROP COLUMN IF EXISTS password_changed_at;
\ No newline at end of file
@@ -0,0 +1,16 @@
+ALTER TABLE members
+ADD COLUMN password_changed_at TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE TABLE member_password_histories (
+	member_password_history_id BIGSERIAL PRIMARY KEY,
+	member_id BIGINT NOT NULL,
+	password TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	CONSTRAINT fk_member_password_histories_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_member_password_histories_member_created
+ON member_password_histories (member_id, created_at DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS refresh_sessions;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE refresh_sessions (
+	session_id TEXT PRIMARY KEY,
+	member_id BIGINT NOT NULL,
+
+	idle_expires_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP NOT NULL DEFAULT now(),
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	revoked_at TIMESTAMP,
+	CONSTRAINT fk_refresh_sessions_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_refresh_sessions_member
+ON refresh_sessions (member_id);
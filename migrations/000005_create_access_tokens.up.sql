@@ -0,0 +1,20 @@
+CREATE TABLE access_tokens (
+	token_hash TEXT PRIMARY KEY,
+	member_id BIGINT NOT NULL,
+
+	claims JSONB NOT NULL,
+
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	revoked_at TIMESTAMP,
+	CONSTRAINT fk_access_tokens_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_access_tokens_member
+ON access_tokens (member_id);
+
+CREATE INDEX idx_access_tokens_expires
+ON access_tokens (expires_at);
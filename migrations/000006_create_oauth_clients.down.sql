@@ -0,0 +1,7 @@
+DELETE FROM access_tokens WHERE member_id IS NULL;
+ALTER TABLE access_tokens
+ALTER COLUMN member_id SET NOT NULL;
+
+DROP TABLE IF EXISTS oauth_consents;
+DROP TABLE IF EXISTS oauth_authorization_codes;
+DROP TABLE IF EXISTS oauth_clients;
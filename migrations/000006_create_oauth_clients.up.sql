@@ -0,0 +1,61 @@
+CREATE TABLE oauth_clients (
+	client_id TEXT PRIMARY KEY,
+	client_secret TEXT,
+
+	name TEXT NOT NULL,
+	redirect_uris TEXT[] NOT NULL DEFAULT '{}',
+	grant_types TEXT[] NOT NULL DEFAULT '{}',
+	scopes TEXT[] NOT NULL DEFAULT '{}',
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	revoked_at TIMESTAMP
+);
+
+CREATE TABLE oauth_authorization_codes (
+	code_hash TEXT PRIMARY KEY,
+	client_id TEXT NOT NULL,
+	member_id BIGINT NOT NULL,
+
+	redirect_uri TEXT NOT NULL,
+	scope TEXT NOT NULL,
+	nonce TEXT NOT NULL DEFAULT '',
+	code_challenge TEXT NOT NULL,
+
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	used_at TIMESTAMP,
+	CONSTRAINT fk_oauth_authorization_codes_client
+		FOREIGN KEY (client_id)
+		REFERENCES oauth_clients(client_id)
+		ON DELETE CASCADE,
+	CONSTRAINT fk_oauth_authorization_codes_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_oauth_authorization_codes_expires
+ON oauth_authorization_codes (expires_at);
+
+CREATE TABLE oauth_consents (
+	member_id BIGINT NOT NULL,
+	client_id TEXT NOT NULL,
+
+	scope TEXT NOT NULL,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	updated_at TIMESTAMP,
+	PRIMARY KEY (member_id, client_id),
+	CONSTRAINT fk_oauth_consents_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE,
+	CONSTRAINT fk_oauth_consents_client
+		FOREIGN KEY (client_id)
+		REFERENCES oauth_clients(client_id)
+		ON DELETE CASCADE
+);
+
+-- client_credentials 토큰은 회원이 없음
+ALTER TABLE access_tokens
+ALTER COLUMN member_id DROP NOT NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS scim_members;
\ No newline at end of file
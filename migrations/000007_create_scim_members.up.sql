@@ -0,0 +1,18 @@
+CREATE TABLE scim_members (
+	member_id BIGINT PRIMARY KEY,
+	tenant_id TEXT NOT NULL,
+	external_id TEXT,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	CONSTRAINT fk_scim_members_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_scim_members_tenant
+ON scim_members (tenant_id);
+
+CREATE UNIQUE INDEX uq_scim_members_tenant_external
+ON scim_members (tenant_id, external_id)
+WHERE external_id IS NOT NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS member_identities;
\ No newline at end of file
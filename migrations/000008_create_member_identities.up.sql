@@ -0,0 +1,15 @@
+CREATE TABLE member_identities (
+	provider TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	member_id BIGINT NOT NULL,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	PRIMARY KEY (provider, subject),
+	CONSTRAINT fk_member_identities_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_member_identities_member
+ON member_identities (member_id);
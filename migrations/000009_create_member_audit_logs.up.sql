@@ -0,0 +1,16 @@
+CREATE TABLE member_audit_logs (
+	audit_log_id BIGSERIAL PRIMARY KEY,
+	actor_id BIGINT NOT NULL,
+	member_id BIGINT NOT NULL,
+	action TEXT NOT NULL,
+	detail TEXT NOT NULL,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- 회원이 삭제되어도 감사 기록은 유지 (FK 없음)
+CREATE INDEX idx_member_audit_logs_member
+ON member_audit_logs (member_id, created_at);
+
+CREATE INDEX idx_member_audit_logs_actor
+ON member_audit_logs (actor_id, created_at);
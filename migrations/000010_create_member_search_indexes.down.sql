@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_members_search_fts;
+DROP INDEX IF EXISTS idx_members_email_trgm;
+DROP INDEX IF EXISTS idx_members_name_trgm;
@@ -0,0 +1,13 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 부분 일치(ILIKE) / 유사도(%) 검색
+CREATE INDEX idx_members_name_trgm
+ON members USING gin (name gin_trgm_ops);
+
+CREATE INDEX idx_members_email_trgm
+ON members USING gin (email gin_trgm_ops);
+
+-- 전문 검색 (simple 사전, 이메일 구분자는 공백 처리)
+-- 검색 쿼리의 to_tsvector 식과 동일해야 인덱스 사용
+CREATE INDEX idx_members_search_fts
+ON members USING gin (to_tsvector('simple', name || ' ' || translate(email, '@.-_+', '     ')));
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS phone_verifications;
+
+ALTER TABLE members
+DROP COLUMN IF EXISTS tel_verified_at;
@@ -0,0 +1,22 @@
+ALTER TABLE members
+ADD COLUMN tel_verified_at TIMESTAMP;
+
+-- 전화번호 인증 코드 (회원별 최신 미인증 건만 유효)
+CREATE TABLE phone_verifications (
+	verification_id BIGSERIAL PRIMARY KEY,
+	member_id BIGINT NOT NULL,
+	tel TEXT NOT NULL,
+	code_hash TEXT NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	expires_at TIMESTAMP NOT NULL,
+	verified_at TIMESTAMP,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	CONSTRAINT fk_phone_verifications_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
+
+CREATE INDEX idx_phone_verifications_member
+ON phone_verifications (member_id, created_at);
@@ -0,0 +1,12 @@
+-- 회원 설정 (키 / 기본값 / 검증은 애플리케이션 스키마 레지스트리에서 관리)
+-- 기본값과 다른 값만 저장, 새 설정 추가 시 마이그레이션 불필요
+CREATE TABLE member_preferences (
+	member_id BIGINT PRIMARY KEY,
+	preferences JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+	updated_at TIMESTAMP NOT NULL DEFAULT now(),
+	CONSTRAINT fk_member_preferences_member
+		FOREIGN KEY (member_id)
+		REFERENCES members(member_id)
+		ON DELETE CASCADE
+);
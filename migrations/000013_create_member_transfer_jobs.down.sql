@@ -0,0 +1 @@
+DROP TABLE IF EXISTS member_transfer_jobs;
\ No newline at end of file
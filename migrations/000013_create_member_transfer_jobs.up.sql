@@ -0,0 +1,22 @@
+-- 회원 가져오기 / 내보내기 비동기 작업 (관리자 API)
+CREATE TABLE member_transfer_jobs (
+	job_id BIGSERIAL PRIMARY KEY,
+	kind TEXT NOT NULL,
+	format TEXT NOT NULL,
+	status TEXT NOT NULL,
+	dry_run BOOLEAN NOT NULL DEFAULT false,
+	total_rows INT NOT NULL DEFAULT 0,
+	succeeded_rows INT NOT NULL DEFAULT 0,
+	failed_rows INT NOT NULL DEFAULT 0,
+	report JSONB,
+	result_key TEXT,
+	error TEXT,
+	created_by BIGINT NOT NULL,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now(),
+	started_at TIMESTAMP,
+	finished_at TIMESTAMP
+);
+
+CREATE INDEX idx_member_transfer_jobs_created
+ON member_transfer_jobs (created_at);
@@ -0,0 +1,16 @@
+CREATE TABLE member_status_history (
+	member_status_history_id BIGSERIAL PRIMARY KEY,
+	member_id BIGINT NOT NULL,
+	from_status TEXT NOT NULL,
+	to_status TEXT NOT NULL,
+	reason TEXT NOT NULL,
+	note TEXT,
+	actor_type TEXT NOT NULL,
+	actor_id TEXT,
+
+	created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- 회원이 삭제되어도 상태 이력은 유지 (FK 없음)
+CREATE INDEX idx_member_status_history_member
+ON member_status_history (member_id, created_at);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_members_pending_signup;
\ No newline at end of file
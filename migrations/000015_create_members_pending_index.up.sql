@@ -0,0 +1,4 @@
+-- 가입 승인 대기열 (READY 회원, 오래된 순)
+CREATE INDEX idx_members_pending_signup
+ON members (created_at, member_id)
+WHERE status = 'READY' AND deleted_at IS NULL;
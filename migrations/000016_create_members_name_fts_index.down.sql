@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_members_name_fts;
\ No newline at end of file
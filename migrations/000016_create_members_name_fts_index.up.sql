@@ -0,0 +1,4 @@
+-- 멘션 검색 전문 검색 (이름만, 이메일은 관리자 검색에서만 사용)
+-- 검색 쿼리의 to_tsvector 식과 동일해야 인덱스 사용
+CREATE INDEX idx_members_name_fts
+ON members USING gin (to_tsvector('simple', name));
@@ -43,3 +43,13 @@ var HttpErrorsTotal = prometheus.NewCounterVec(
 	},
 	[]string{"method", "path", "status"},
 )
+
+// 인증 미들웨어의 만료 access 토큰 자동 재발급 수
+var AccessTokenRenewalsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "auth",
+		Name:      "access_token_renewals_total",
+		Help:      "Expired access tokens renewed in auth middleware.",
+	},
+	[]string{"result"},
+)
@@ -0,0 +1,16 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// URL-safe 랜덤 문자열 생성 (size: 바이트 수)
+func RandomString(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}